@@ -239,21 +239,31 @@ func run(c *cli.Context) error {
 							return WithKeychainList(func(keychains *keychain.List) error {
 								// Unlock the encrypted vault.
 								return WithVault(locations, keychains, crashHandler, func(v *vault.Vault, insecure, corrupt bool) error {
+									var (
+										configMigrated     events.ConfigMigrated
+										haveConfigMigrated bool
+									)
+
 									if !v.Migrated() {
 										// Migrate old settings into the vault.
-										if err := migrateOldSettings(v); err != nil {
-											logrus.WithError(err).Error("Failed to migrate old settings")
+										settingsErr := migrateOldSettings(v)
+										if settingsErr != nil {
+											logrus.WithError(settingsErr).Error("Failed to migrate old settings")
 										}
 
 										// Migrate old accounts into the vault.
-										if err := migrateOldAccounts(locations, keychains, v); err != nil {
-											logrus.WithError(err).Error("Failed to migrate old accounts")
+										accountsErr := migrateOldAccounts(locations, keychains, v)
+										if accountsErr != nil {
+											logrus.WithError(accountsErr).Error("Failed to migrate old accounts")
 										}
 
 										// The vault has been migrated.
 										if err := v.SetMigrated(); err != nil {
 											logrus.WithError(err).Error("Failed to mark vault as migrated")
 										}
+
+										// The last version has been migrated along with the old settings.
+										configMigrated, haveConfigMigrated = newConfigMigratedEvent(v.GetLastVersion(), version, settingsErr, accountsErr)
 									}
 
 									logrus.WithFields(logrus.Fields{
@@ -279,6 +289,10 @@ func run(c *cli.Context) error {
 												b.PushError(bridge.ErrVaultCorrupt)
 											}
 
+											if haveConfigMigrated {
+												b.PublishConfigMigrated(configMigrated)
+											}
+
 											// Remove old updates files
 											b.RemoveOldUpdates()
 
@@ -310,10 +324,10 @@ func withSingleInstance(settingPath, lockFile string, version *semver.Version, f
 
 	lock, err := checkSingleInstance(settingPath, lockFile, version)
 	if err != nil {
-		logrus.Info("Another instance is already running; raising it")
+		logrus.WithError(err).Info("Another instance is already running; raising it")
 
-		if ok := focus.TryRaise(settingPath); !ok {
-			return fmt.Errorf("another instance is already running but it could not be raised")
+		if ok := focus.TryRaiseFromInstance(settingPath, os.Getpid()); !ok {
+			return fmt.Errorf("another instance is already running but it could not be raised: %w", err)
 		}
 
 		logrus.Info("The other instance has been raised")
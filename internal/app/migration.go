@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/legacy/credentials"
 	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
 	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
@@ -122,6 +123,28 @@ func migrateOldSettingsWithDir(configDir string, v *vault.Vault) error {
 	return v.SetBridgeTLSCertKey(certPEM, keyPEM)
 }
 
+// newConfigMigratedEvent returns the event reporting the outcome of a legacy config migration.
+// If there was no legacy config to migrate and nothing failed, false is returned and no event should be published.
+func newConfigMigratedEvent(fromVersion, toVersion *semver.Version, errs ...error) (events.ConfigMigrated, bool) {
+	success := true
+
+	for _, err := range errs {
+		if err != nil {
+			success = false
+		}
+	}
+
+	if success && fromVersion.Equal(semver.MustParse("0.0.0")) {
+		return events.ConfigMigrated{}, false
+	}
+
+	return events.ConfigMigrated{
+		FromVersion: fromVersion.String(),
+		ToVersion:   toVersion.String(),
+		Success:     success,
+	}, true
+}
+
 func migrateOldAccounts(locations *locations.Locations, keychains *keychain.List, v *vault.Vault) error {
 	logrus.Info("Migrating accounts")
 
@@ -28,9 +28,37 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// anotherInstanceRunningError is returned when another instance holds the lock file and must be left running.
+// The PID of the running instance is 0 if it could not be determined.
+type anotherInstanceRunningError struct {
+	pid    int
+	reason error
+}
+
+func newAnotherInstanceRunningError(lockFilePath string, reason error) *anotherInstanceRunningError {
+	pid, err := getPID(lockFilePath)
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to get PID of running instance")
+	}
+
+	return &anotherInstanceRunningError{
+		pid:    pid,
+		reason: reason,
+	}
+}
+
+func (err *anotherInstanceRunningError) Error() string {
+	return fmt.Sprintf("another instance is running (PID %d): %v", err.pid, err.reason)
+}
+
+func (err *anotherInstanceRunningError) Unwrap() error {
+	return err.reason
+}
+
 // checkSingleInstance checks if another instance of the application is already running.
 // It tries to create a lock file at the given path.
 // If it succeeds, it returns the lock file and a nil error.
+// If another instance must be left running, it returns an anotherInstanceRunningError describing it.
 //
 // For macOS and Linux when already running version is older than this instance
 // it will kill old and continue with this new bridge (i.e. no error returned).
@@ -46,11 +74,11 @@ func checkSingleInstance(settingPath, lockFilePath string, curVersion *semver.Ve
 	// Check if it's an older version of the app.
 	lastVersion, ok := focus.TryVersion(settingPath)
 	if !ok {
-		return nil, fmt.Errorf("failed to determine version of running instance")
+		return nil, newAnotherInstanceRunningError(lockFilePath, fmt.Errorf("failed to determine version of running instance"))
 	}
 
 	if !lastVersion.LessThan(curVersion) {
-		return nil, fmt.Errorf("running instance is newer than this one")
+		return nil, newAnotherInstanceRunningError(lockFilePath, fmt.Errorf("running instance is newer than this one"))
 	}
 
 	// The other instance is an older version, so we should kill it.
@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/allan-simon/go-singleinstance"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSingleInstance_AnotherInstanceRunning(t *testing.T) {
+	settingsPath, lockFile := t.TempDir(), filepath.Join(t.TempDir(), "bridge.lock")
+
+	// Simulate another instance holding the lock.
+	lock, err := singleinstance.CreateLockFile(lockFile)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, lock.Close()) }()
+
+	// The second instance refuses to start.
+	err = withSingleInstance(settingsPath, lockFile, semver.MustParse("3.0.0"), func() error {
+		t.Fatal("The second instance should not start")
+		return nil
+	})
+	require.Error(t, err)
+
+	// The error describes the running instance.
+	var instanceErr *anotherInstanceRunningError
+	require.True(t, errors.As(err, &instanceErr))
+	require.Equal(t, os.Getpid(), instanceErr.pid)
+}
+
+func TestSingleInstance_NoOtherInstance(t *testing.T) {
+	settingsPath, lockFile := t.TempDir(), filepath.Join(t.TempDir(), "bridge.lock")
+
+	var started bool
+
+	require.NoError(t, withSingleInstance(settingsPath, lockFile, semver.MustParse("3.0.0"), func() error {
+		started = true
+		return nil
+	}))
+
+	require.True(t, started)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/sirupsen/logrus"
+)
+
+// maxUserBadEvents is the number of events an account may fail to handle before it is disabled.
+const maxUserBadEvents = 3
+
+// badEventGuard counts the events each account failed to handle, and disables an account which keeps failing so that
+// it does not keep disrupting the others. The counts are kept until the account is logged out or completes a sync,
+// e.g. the resync which follows a bad event: only the events failing since the account was last known to be healthy
+// count towards disabling it.
+type badEventGuard struct {
+	max     int
+	disable func(userID string)
+	publish func(event events.Event)
+
+	lock   sync.Mutex
+	counts map[string]int
+}
+
+func newBadEventGuard(max int, disable func(userID string), publish func(event events.Event)) *badEventGuard {
+	return &badEventGuard{
+		max:     max,
+		disable: disable,
+		publish: publish,
+		counts:  make(map[string]int),
+	}
+}
+
+// onBadEvent records that the account failed to handle an event. It returns true if the account was disabled as a
+// result, in which case the failure must not be handled any further.
+func (g *badEventGuard) onBadEvent(userID string, err error) bool {
+	g.lock.Lock()
+	g.counts[userID]++
+	count := g.counts[userID]
+	g.lock.Unlock()
+
+	if count < g.max {
+		return false
+	}
+
+	reason := fmt.Sprintf("failed to handle %d events, last error: %v", count, err)
+
+	logrus.WithField("userID", userID).WithField("reason", reason).Warn("Disabling account")
+
+	g.disable(userID)
+
+	g.publish(events.AccountAutoDisabled{
+		UserID: userID,
+		Reason: reason,
+	})
+
+	return true
+}
+
+// reset forgets the events the account failed to handle, once it is logged out or has synced successfully.
+func (g *badEventGuard) reset(userID string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	delete(g.counts, userID)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBadEventGuard_DisablesFailingAccountOnly(t *testing.T) {
+	var (
+		disabled  []string
+		published []events.Event
+	)
+
+	guard := newBadEventGuard(3, func(userID string) {
+		disabled = append(disabled, userID)
+	}, func(event events.Event) {
+		published = append(published, event)
+	})
+
+	// Both accounts fail to handle events, but only the first one keeps failing.
+	require.False(t, guard.onBadEvent("user1", errors.New("bad event")))
+	require.False(t, guard.onBadEvent("user2", errors.New("bad event")))
+	require.False(t, guard.onBadEvent("user1", errors.New("bad event")))
+	require.Empty(t, disabled)
+	require.Empty(t, published)
+
+	require.True(t, guard.onBadEvent("user1", errors.New("bad event")))
+	require.Equal(t, []string{"user1"}, disabled)
+	require.Len(t, published, 1)
+
+	event, ok := published[0].(events.AccountAutoDisabled)
+	require.True(t, ok)
+	require.Equal(t, "user1", event.UserID)
+	require.Contains(t, event.Reason, "bad event")
+
+	require.False(t, guard.onBadEvent("user2", errors.New("bad event")))
+	require.Equal(t, []string{"user1"}, disabled)
+}
+
+func TestBadEventGuard_Reset(t *testing.T) {
+	var disabled []string
+
+	guard := newBadEventGuard(2, func(userID string) {
+		disabled = append(disabled, userID)
+	}, func(events.Event) {})
+
+	require.False(t, guard.onBadEvent("user", errors.New("bad event")))
+
+	// Once logged out or synced again, the account starts afresh.
+	guard.reset("user")
+
+	require.False(t, guard.onBadEvent("user", errors.New("bad event")))
+	require.Empty(t, disabled)
+
+	require.True(t, guard.onBadEvent("user", errors.New("bad event")))
+	require.Equal(t, []string{"user"}, disabled)
+}
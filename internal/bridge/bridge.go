@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -59,13 +60,21 @@ type Bridge struct {
 	users     map[string]*user.User
 	usersLock safe.RWMutex
 
+	// badEvents disables the users which keep failing to handle events.
+	badEvents *badEventGuard
+
+	// shutdownSendTimeout bounds the time spent waiting for the messages being sent when closing.
+	shutdownSendTimeout atomic.Int64
+
 	// api manages user API clients.
 	api        *proton.Manager
 	proxyCtl   ProxyController
 	identifier identifier.Identifier
 
 	// tlsConfig holds the bridge TLS config used by the IMAP and SMTP servers.
+	// It serves tlsCert, which is replaced when the certificate is regenerated.
 	tlsConfig *tls.Config
+	tlsCert   atomic.Pointer[tls.Certificate]
 
 	// imapServer is the bridge's IMAP server.
 	imapEventCh chan imapEvents.Event
@@ -155,8 +164,11 @@ func New(
 	logIMAPClient, logIMAPServer bool, // whether to log IMAP client/server activity
 	logSMTP bool, // whether to log SMTP activity
 ) (*Bridge, <-chan events.Event, error) {
+	// rateLimitRoundTripper reports when the API asks us to slow down.
+	rateLimitRoundTripper := newRateLimitRoundTripper(roundTripper)
+
 	// api is the user's API manager.
-	api := proton.New(newAPIOptions(apiURL, curVersion, cookieJar, roundTripper, panicHandler)...)
+	api := proton.New(newAPIOptions(apiURL, curVersion, cookieJar, rateLimitRoundTripper, panicHandler)...)
 
 	// tasks holds all the bridge's background tasks.
 	tasks := async.NewGroup(context.Background(), panicHandler)
@@ -194,7 +206,7 @@ func New(
 	eventCh, _ := bridge.GetEvents()
 
 	// Initialize all of bridge's background tasks and operations.
-	if err := bridge.init(tlsReporter); err != nil {
+	if err := bridge.init(tlsReporter, rateLimitRoundTripper.rateLimitCh); err != nil {
 		return nil, nil, fmt.Errorf("failed to initialize bridge: %w", err)
 	}
 
@@ -223,7 +235,7 @@ func newBridge(
 
 	logIMAPClient, logIMAPServer, logSMTP bool,
 ) (*Bridge, error) {
-	tlsConfig, err := loadTLSConfig(vault)
+	tlsCert, err := loadTLSCert(vault)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load TLS config: %w", err)
 	}
@@ -255,7 +267,6 @@ func newBridge(
 		proxyCtl:   proxyCtl,
 		identifier: identifier,
 
-		tlsConfig:   tlsConfig,
 		imapEventCh: imapEventCh,
 
 		updater:   updater,
@@ -287,6 +298,18 @@ func newBridge(
 		syncService: syncservice.NewService(reporter, panicHandler),
 	}
 
+	bridge.tlsCert.Store(tlsCert)
+	bridge.tlsConfig = &tls.Config{
+		// The certificate is looked up on every handshake, so that a regenerated certificate is used right away.
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return bridge.tlsCert.Load(), nil
+		},
+		MinVersion: tls.VersionTLS12,
+	}
+
+	bridge.badEvents = newBadEventGuard(maxUserBadEvents, bridge.disableUser, bridge.publish)
+	bridge.shutdownSendTimeout.Store(int64(defaultShutdownSendTimeout))
+
 	bridge.serverManager = imapsmtpserver.NewService(context.Background(),
 		&bridgeSMTPSettings{b: bridge},
 		&bridgeIMAPSettings{b: bridge},
@@ -312,7 +335,7 @@ func newBridge(
 	return bridge, nil
 }
 
-func (bridge *Bridge) init(tlsReporter TLSReporter) error {
+func (bridge *Bridge) init(tlsReporter TLSReporter, rateLimitCh <-chan events.Event) error {
 	// Enable or disable the proxy at startup.
 	if bridge.vault.GetProxyAllowed() {
 		bridge.proxyCtl.AllowProxy()
@@ -364,6 +387,21 @@ func (bridge *Bridge) init(tlsReporter TLSReporter) error {
 		})
 	})
 
+	// Publish a rate limit event if the API throttles us.
+	bridge.tasks.Once(func(ctx context.Context) {
+		async.RangeContext(ctx, rateLimitCh, func(event events.Event) {
+			switch event := event.(type) {
+			case events.ServerRateLimited:
+				logrus.WithField("endpoint", event.Endpoint).WithField("retryAfter", event.RetryAfter).Warn("API rate limit encountered")
+
+			case events.KeyGenerationRateLimited:
+				logrus.WithField("retryAfter", event.RetryAfter).Warn("Key generation rate limit encountered")
+			}
+
+			bridge.publish(event)
+		})
+	})
+
 	// Publish a raise event if the focus service is called.
 	bridge.tasks.Once(func(ctx context.Context) {
 		async.RangeContext(ctx, bridge.focusService.GetRaiseCh(), func(struct{}) {
@@ -372,6 +410,14 @@ func (bridge *Bridge) init(tlsReporter TLSReporter) error {
 		})
 	})
 
+	// Publish an event when another instance is launched, which then raises this one.
+	bridge.tasks.Once(func(ctx context.Context) {
+		async.RangeContext(ctx, bridge.focusService.GetAnotherInstanceCh(), func(pid int) {
+			logrus.WithField("pid", pid).Info("Another instance was launched")
+			bridge.publish(events.AnotherInstanceRunning{PID: pid})
+		})
+	})
+
 	// Handle any IMAP events that are forwarded to the bridge from gluon.
 	bridge.tasks.Once(func(ctx context.Context) {
 		async.RangeContext(ctx, bridge.imapEventCh, func(event imapEvents.Event) {
@@ -380,6 +426,9 @@ func (bridge *Bridge) init(tlsReporter TLSReporter) error {
 		})
 	})
 
+	// Let the frontend know how we log.
+	bridge.publish(bridge.getLoggingInfo())
+
 	// Attempt to load users from the vault when triggered.
 	bridge.goLoad = bridge.tasks.Trigger(func(ctx context.Context) {
 		if err := bridge.loadUsers(ctx); err != nil {
@@ -433,12 +482,20 @@ func (bridge *Bridge) GetErrors() []error {
 	return bridge.errors
 }
 
+// PublishConfigMigrated notifies the frontend of the outcome of a config migration that ran before bridge was created.
+func (bridge *Bridge) PublishConfigMigrated(event events.ConfigMigrated) {
+	bridge.publish(event)
+}
+
 func (bridge *Bridge) Close(ctx context.Context) {
 	logrus.Info("Closing bridge")
 
 	// Stop heart beat before closing users.
 	bridge.heartbeat.stop()
 
+	// Give the messages being sent a chance to complete before closing users.
+	bridge.waitPendingSends(ctx)
+
 	// Close all users.
 	safe.Lock(func() {
 		for _, user := range bridge.users {
@@ -535,16 +592,13 @@ func (bridge *Bridge) onStatusDown(ctx context.Context) {
 	}
 }
 
-func loadTLSConfig(vault *vault.Vault) (*tls.Config, error) {
+func loadTLSCert(vault *vault.Vault) (*tls.Certificate, error) {
 	cert, err := tls.X509KeyPair(vault.GetBridgeTLSCert())
 	if err != nil {
 		return nil, err
 	}
 
-	return &tls.Config{
-		Certificates: []tls.Certificate{cert},
-		MinVersion:   tls.VersionTLS12,
-	}, nil
+	return &cert, nil
 }
 
 func min(a, b time.Duration) time.Duration {
@@ -20,6 +20,7 @@ package bridge_test
 import (
 	"context"
 	"crypto/tls"
+	"encoding/pem"
 	"fmt"
 	"net"
 	"net/http"
@@ -123,6 +124,89 @@ func TestBridge_TLSIssue(t *testing.T) {
 	})
 }
 
+func TestBridge_RegenerateTLSCert(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(bridge *bridge.Bridge, _ *bridge.Mocks) {
+			// Get a stream of certificate regeneration events.
+			certEventCh, done := chToType[events.Event, events.CertRegenerated](bridge.GetEvents(events.CertRegenerated{}))
+			defer done()
+
+			// Regenerate the certificate.
+			require.NoError(t, bridge.RegenerateBridgeTLSCert())
+
+			newCert, _ := bridge.GetBridgeTLSCert()
+
+			fingerprint, err := certs.GetFingerprint(newCert)
+			require.NoError(t, err)
+
+			// The event carries the fingerprint of the new certificate.
+			event := <-certEventCh
+			require.True(t, event.Success)
+			require.Equal(t, fingerprint, event.Fingerprint)
+		})
+	})
+}
+
+func TestBridge_RegenerateTLSCert_ServedRightAway(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			smtpWaiter := waitForSMTPServerReady(b)
+			defer smtpWaiter.Done()
+
+			_, err := b.LoginFull(context.Background(), username, password, nil, nil)
+			require.NoError(t, err)
+
+			smtpWaiter.Wait()
+
+			// Regenerate the certificate while the SMTP server is running.
+			require.NoError(t, b.RegenerateBridgeTLSCert())
+
+			newCert, _ := b.GetBridgeTLSCert()
+
+			fingerprint, err := certs.GetFingerprint(newCert)
+			require.NoError(t, err)
+
+			client, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+			require.NoError(t, err)
+			defer client.Close() //nolint:errcheck
+
+			require.NoError(t, client.StartTLS(&tls.Config{InsecureSkipVerify: true}))
+
+			// The new certificate is served without restarting the server.
+			state, ok := client.TLSConnectionState()
+			require.True(t, ok)
+
+			servedFingerprint, err := certs.GetFingerprint(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: state.PeerCertificates[0].Raw}))
+			require.NoError(t, err)
+			require.Equal(t, fingerprint, servedFingerprint)
+		})
+	})
+}
+
+func TestBridge_RegenerateTLSCert_CustomCert(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			certEventCh, done := chToType[events.Event, events.CertRegenerated](b.GetEvents(events.CertRegenerated{}))
+			defer done()
+
+			// Use the current certificate as a custom one.
+			cert, key := b.GetBridgeTLSCert()
+
+			certPath, keyPath := filepath.Join(t.TempDir(), "cert.pem"), filepath.Join(t.TempDir(), "key.pem")
+			require.NoError(t, os.WriteFile(certPath, cert, 0o600))
+			require.NoError(t, os.WriteFile(keyPath, key, 0o600))
+			require.NoError(t, b.SetBridgeTLSCertPath(certPath, keyPath))
+
+			// The custom certificate would take precedence over a regenerated one.
+			require.ErrorIs(t, b.RegenerateBridgeTLSCert(), bridge.ErrCustomTLSCert)
+			require.False(t, (<-certEventCh).Success)
+
+			newCert, _ := b.GetBridgeTLSCert()
+			require.Equal(t, cert, newCert)
+		})
+	})
+}
+
 func TestBridge_Focus(t *testing.T) {
 	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
 		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(bridge *bridge.Bridge, mocks *bridge.Mocks) {
@@ -142,6 +226,23 @@ func TestBridge_Focus(t *testing.T) {
 	})
 }
 
+func TestBridge_AnotherInstanceLaunched(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(bridge *bridge.Bridge, mocks *bridge.Mocks) {
+			instanceCh, done := chToType[events.Event, events.AnotherInstanceRunning](bridge.GetEvents(events.AnotherInstanceRunning{}))
+			defer done()
+
+			settingsFolder, err := locator.ProvideSettingsPath()
+			require.NoError(t, err)
+
+			// Simulate another instance being launched.
+			require.True(t, focus.TryRaiseFromInstance(settingsFolder, 42))
+
+			require.Equal(t, 42, (<-instanceCh).PID)
+		})
+	})
+}
+
 func TestBridge_UserAgent(t *testing.T) {
 	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
 		var (
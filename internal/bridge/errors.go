@@ -30,4 +30,6 @@ var (
 	ErrNotImplemented      = errors.New("not implemented")
 
 	ErrSizeTooLarge = errors.New("file is too big")
+
+	ErrCustomTLSCert = errors.New("a custom TLS certificate is in use")
 )
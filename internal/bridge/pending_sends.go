@@ -0,0 +1,92 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultShutdownSendTimeout is the default maximum time spent waiting for the messages being sent when closing.
+const defaultShutdownSendTimeout = 10 * time.Second
+
+// inFlightWaiter waits for the messages it is sending to complete.
+type inFlightWaiter interface {
+	WaitAllInFlight(ctx context.Context) int
+}
+
+// SetShutdownSendTimeout sets the maximum time spent waiting for the messages being sent when closing.
+// A non-positive timeout disables the wait.
+func (bridge *Bridge) SetShutdownSendTimeout(timeout time.Duration) {
+	bridge.shutdownSendTimeout.Store(int64(timeout))
+}
+
+// waitPendingSends waits for the messages being sent by the users to complete.
+// If some are still in flight when the timeout expires, they are abandoned and reported.
+func (bridge *Bridge) waitPendingSends(ctx context.Context) {
+	waiters := safe.RLockRet(func() []inFlightWaiter {
+		waiters := make([]inFlightWaiter, 0, len(bridge.users))
+
+		for _, user := range bridge.users {
+			waiters = append(waiters, user)
+		}
+
+		return waiters
+	}, bridge.usersLock)
+
+	abandoned := waitPendingSends(ctx, time.Duration(bridge.shutdownSendTimeout.Load()), waiters, bridge.panicHandler)
+	if abandoned == 0 {
+		return
+	}
+
+	logrus.WithField("abandoned", abandoned).Warn("Timed out waiting for messages being sent, abandoning them")
+
+	bridge.publish(events.ShutdownPendingSends{Abandoned: abandoned})
+}
+
+// waitPendingSends waits concurrently for all waiters, for at most the given timeout.
+// It returns the number of messages which were still in flight when the wait ended.
+func waitPendingSends(ctx context.Context, timeout time.Duration, waiters []inFlightWaiter, panicHandler async.PanicHandler) int {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	abandonedCh := make(chan int, len(waiters))
+
+	for _, waiter := range waiters {
+		waiter := waiter
+
+		go func() {
+			defer async.HandlePanic(panicHandler)
+
+			abandonedCh <- waiter.WaitAllInFlight(ctx)
+		}()
+	}
+
+	var abandoned int
+
+	for range waiters {
+		abandoned += <-abandonedCh
+	}
+
+	return abandoned
+}
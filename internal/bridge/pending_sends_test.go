@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/stretchr/testify/require"
+)
+
+// testInFlightWaiter has inFlight messages being sent until doneCh is closed.
+type testInFlightWaiter struct {
+	inFlight int
+	doneCh   chan struct{}
+}
+
+func newTestInFlightWaiter(inFlight int) *testInFlightWaiter {
+	return &testInFlightWaiter{inFlight: inFlight, doneCh: make(chan struct{})}
+}
+
+func (w *testInFlightWaiter) WaitAllInFlight(ctx context.Context) int {
+	select {
+	case <-w.doneCh:
+		return 0
+
+	case <-ctx.Done():
+		return w.inFlight
+	}
+}
+
+func TestWaitPendingSends_Clean(t *testing.T) {
+	waiter1, waiter2 := newTestInFlightWaiter(1), newTestInFlightWaiter(2)
+
+	// The sends complete well before the timeout.
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(waiter1.doneCh)
+		close(waiter2.doneCh)
+	}()
+
+	abandoned := waitPendingSends(context.Background(), 10*time.Second, []inFlightWaiter{waiter1, waiter2}, async.NoopPanicHandler{})
+	require.Zero(t, abandoned)
+}
+
+func TestWaitPendingSends_Timeout(t *testing.T) {
+	waiter1, waiter2, waiter3 := newTestInFlightWaiter(1), newTestInFlightWaiter(2), newTestInFlightWaiter(3)
+
+	// Only the sends of the first waiter complete in time.
+	close(waiter1.doneCh)
+
+	start := time.Now()
+
+	abandoned := waitPendingSends(context.Background(), 100*time.Millisecond, []inFlightWaiter{waiter1, waiter2, waiter3}, async.NoopPanicHandler{})
+	require.Equal(t, 5, abandoned)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestWaitPendingSends_NoTimeout(t *testing.T) {
+	waiter := newTestInFlightWaiter(1)
+
+	// A non-positive timeout does not wait at all.
+	require.Equal(t, 1, waitPendingSends(context.Background(), 0, []inFlightWaiter{waiter}, async.NoopPanicHandler{}))
+}
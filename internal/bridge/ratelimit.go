@@ -0,0 +1,107 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultRetryAfter is the delay applied by the API client when a 429 response has no valid Retry-After header.
+const defaultRetryAfter = 10 * time.Second
+
+// keyGenerationEndpoints are the API endpoints which generate new keys when POSTed to.
+var keyGenerationEndpoints = []string{ //nolint:gochecknoglobals
+	"/core/v4/keys",
+	"/core/v4/keys/setup",
+	"/core/v4/keys/address",
+}
+
+// rateLimitRoundTripper wraps the API round tripper and reports every 429 response received from the API.
+// The API client handles the backoff itself; this is only used to let the user know why requests are slow.
+// Rate limited key generation requests are additionally reported as a events.KeyGenerationRateLimited event.
+type rateLimitRoundTripper struct {
+	http.RoundTripper
+
+	rateLimitCh chan events.Event
+}
+
+func newRateLimitRoundTripper(roundTripper http.RoundTripper) *rateLimitRoundTripper {
+	return &rateLimitRoundTripper{
+		RoundTripper: roundTripper,
+		rateLimitCh:  make(chan events.Event, 16),
+	}
+}
+
+func (rt *rateLimitRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := rt.RoundTripper.RoundTrip(req)
+	if err != nil || res.StatusCode != http.StatusTooManyRequests {
+		return res, err
+	}
+
+	retryAfter := parseRetryAfter(res.Header.Get("Retry-After"))
+
+	rt.report(events.ServerRateLimited{RetryAfter: retryAfter, Endpoint: req.URL.Path})
+
+	if isKeyGenerationRequest(req) {
+		rt.report(events.KeyGenerationRateLimited{RetryAfter: retryAfter})
+	}
+
+	return res, nil
+}
+
+func (rt *rateLimitRoundTripper) report(event events.Event) {
+	// Never block a request because nobody is consuming rate limit events.
+	select {
+	case rt.rateLimitCh <- event:
+	default:
+		logrus.WithField("event", event).Warn("Dropping rate limit event")
+	}
+}
+
+// isKeyGenerationRequest returns whether the given request asks the API to generate new keys.
+func isKeyGenerationRequest(req *http.Request) bool {
+	if req.Method != http.MethodPost {
+		return false
+	}
+
+	path := strings.TrimSuffix(req.URL.Path, "/")
+
+	for _, endpoint := range keyGenerationEndpoints {
+		if path == endpoint {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseRetryAfter parses the Retry-After header the same way the API client does, i.e. as a number of seconds.
+func parseRetryAfter(header string) time.Duration {
+	seconds, err := strconv.Atoi(header)
+	if err != nil || seconds < 0 {
+		return defaultRetryAfter
+	}
+
+	return time.Duration(seconds) * time.Second
+}
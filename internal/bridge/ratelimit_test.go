@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitRoundTripper_TooManyRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/core/v4/events" {
+			w.Header().Set("Retry-After", "5")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer srv.Close()
+
+	rt := newRateLimitRoundTripper(http.DefaultTransport)
+	client := &http.Client{Transport: rt}
+
+	// A successful request is not reported.
+	res, err := client.Get(srv.URL + "/core/v4/users")
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Empty(t, rt.rateLimitCh)
+
+	// A 429 response is reported along with its Retry-After delay.
+	res, err = client.Get(srv.URL + "/core/v4/events?ID=abc")
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+
+	select {
+	case event := <-rt.rateLimitCh:
+		require.Equal(t, events.ServerRateLimited{RetryAfter: 5 * time.Second, Endpoint: "/core/v4/events"}, event)
+
+	default:
+		t.Fatal("expected a rate limit event")
+	}
+}
+
+func TestRateLimitRoundTripper_KeyGeneration(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	rt := newRateLimitRoundTripper(http.DefaultTransport)
+	client := &http.Client{Transport: rt}
+
+	// Rate limited public key lookups are not key generation.
+	res, err := client.Get(srv.URL + "/core/v4/keys")
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, events.ServerRateLimited{RetryAfter: 30 * time.Second, Endpoint: "/core/v4/keys"}, <-rt.rateLimitCh)
+	require.Empty(t, rt.rateLimitCh)
+
+	// Rate limited key generation is reported as such, after the generic rate limit event.
+	res, err = client.Post(srv.URL+"/core/v4/keys/address", "application/json", nil)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, events.ServerRateLimited{RetryAfter: 30 * time.Second, Endpoint: "/core/v4/keys/address"}, <-rt.rateLimitCh)
+	require.Equal(t, events.KeyGenerationRateLimited{RetryAfter: 30 * time.Second}, <-rt.rateLimitCh)
+	require.Empty(t, rt.rateLimitCh)
+}
+
+func TestRateLimitRoundTripper_ParseRetryAfter(t *testing.T) {
+	require.Equal(t, 30*time.Second, parseRetryAfter("30"))
+	require.Equal(t, defaultRetryAfter, parseRetryAfter(""))
+	require.Equal(t, defaultRetryAfter, parseRetryAfter("-1"))
+	require.Equal(t, defaultRetryAfter, parseRetryAfter("Wed, 21 Oct 2015 07:28:00 GMT"))
+}
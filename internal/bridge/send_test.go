@@ -755,3 +755,204 @@ func TestBridge_SendAddressDisabled(t *testing.T) {
 		})
 	})
 }
+
+func TestBridge_SendCompletedEvent(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		_, _, err := s.CreateUser("recipient", password)
+		require.NoError(t, err)
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, _ *bridge.Mocks) {
+			smtpWaiter := waitForSMTPServerReady(bridge)
+			defer smtpWaiter.Done()
+
+			senderUserID, err := bridge.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			recipientUserID, err := bridge.LoginFull(ctx, "recipient", password, nil, nil)
+			require.NoError(t, err)
+
+			smtpWaiter.Wait()
+
+			senderInfo, err := bridge.GetUserInfo(senderUserID)
+			require.NoError(t, err)
+
+			recipientInfo, err := bridge.GetUserInfo(recipientUserID)
+			require.NoError(t, err)
+
+			sendCh, done := bridge.GetEvents(events.SendCompleted{})
+			defer done()
+
+			// Dial the server.
+			client, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(bridge.GetSMTPPort())))
+			require.NoError(t, err)
+			defer client.Close() //nolint:errcheck
+
+			// Upgrade to TLS.
+			require.NoError(t, client.StartTLS(&tls.Config{InsecureSkipVerify: true}))
+			require.NoError(t, client.Auth(sasl.NewLoginClient(
+				senderInfo.Addresses[0],
+				string(senderInfo.BridgePass)),
+			))
+
+			// Send the message.
+			literal := "Subject: Test 1\r\n\r\nHello world!"
+			before := time.Now()
+			require.NoError(t, client.SendMail(
+				senderInfo.Addresses[0],
+				[]string{recipientInfo.Addresses[0]},
+				strings.NewReader(literal),
+			))
+			elapsed := time.Since(before)
+
+			// The send path should report the send with plausible timings.
+			event, ok := (<-sendCh).(events.SendCompleted)
+			require.True(t, ok)
+			require.Equal(t, senderUserID, event.UserID)
+			require.NotEmpty(t, event.MessageID)
+			require.GreaterOrEqual(t, event.Size, len(literal))
+			require.Positive(t, event.Duration)
+			require.LessOrEqual(t, event.Duration, elapsed)
+			require.LessOrEqual(t, event.HashDuration, event.Duration)
+		})
+	})
+}
+
+func TestBridge_SentFromAddressEvent(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		_, _, err := s.CreateUser("recipient", password)
+		require.NoError(t, err)
+
+		senderUserID, _, err := s.CreateUser("sender", password)
+		require.NoError(t, err)
+
+		alias := "alias@" + s.GetDomain()
+
+		_, err = s.CreateAddress(senderUserID, alias, password)
+		require.NoError(t, err)
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, _ *bridge.Mocks) {
+			smtpWaiter := waitForSMTPServerReady(bridge)
+			defer smtpWaiter.Done()
+
+			senderUserID, err := bridge.LoginFull(ctx, "sender", password, nil, nil)
+			require.NoError(t, err)
+
+			recipientUserID, err := bridge.LoginFull(ctx, "recipient", password, nil, nil)
+			require.NoError(t, err)
+
+			smtpWaiter.Wait()
+
+			senderInfo, err := bridge.GetUserInfo(senderUserID)
+			require.NoError(t, err)
+
+			recipientInfo, err := bridge.GetUserInfo(recipientUserID)
+			require.NoError(t, err)
+
+			sendCh, done := bridge.GetEvents(events.SendCompleted{}, events.SentFromAddress{})
+			defer done()
+
+			// Dial the server.
+			client, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(bridge.GetSMTPPort())))
+			require.NoError(t, err)
+			defer client.Close() //nolint:errcheck
+
+			// Upgrade to TLS.
+			require.NoError(t, client.StartTLS(&tls.Config{InsecureSkipVerify: true}))
+			require.NoError(t, client.Auth(sasl.NewLoginClient(
+				senderInfo.Addresses[0],
+				string(senderInfo.BridgePass)),
+			))
+
+			// Send the message from the alias, while authenticated with the primary address.
+			require.NoError(t, client.SendMail(
+				senderInfo.Addresses[0],
+				[]string{recipientInfo.Addresses[0]},
+				strings.NewReader("From: Sender <"+alias+">\r\nSubject: Test 1\r\n\r\nHello world!"),
+			))
+
+			completed, ok := (<-sendCh).(events.SendCompleted)
+			require.True(t, ok)
+
+			// The event reports the address found in the From header.
+			event, ok := (<-sendCh).(events.SentFromAddress)
+			require.True(t, ok)
+			require.Equal(t, senderUserID, event.UserID)
+			require.Equal(t, completed.MessageID, event.MessageID)
+			require.Equal(t, alias, event.FromAddress)
+		})
+	})
+}
+
+func TestBridge_SendDuplicate(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		_, _, err := s.CreateUser("recipient", password)
+		require.NoError(t, err)
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, _ *bridge.Mocks) {
+			smtpWaiter := waitForSMTPServerReady(bridge)
+			defer smtpWaiter.Done()
+
+			senderUserID, err := bridge.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			recipientUserID, err := bridge.LoginFull(ctx, "recipient", password, nil, nil)
+			require.NoError(t, err)
+
+			smtpWaiter.Wait()
+
+			senderInfo, err := bridge.GetUserInfo(senderUserID)
+			require.NoError(t, err)
+
+			recipientInfo, err := bridge.GetUserInfo(recipientUserID)
+			require.NoError(t, err)
+
+			sendCh, done := bridge.GetEvents(events.SendCompleted{})
+			defer done()
+
+			send := func(literal string) {
+				client, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(bridge.GetSMTPPort())))
+				require.NoError(t, err)
+				defer client.Close() //nolint:errcheck
+
+				require.NoError(t, client.StartTLS(&tls.Config{InsecureSkipVerify: true}))
+				require.NoError(t, client.Auth(sasl.NewLoginClient(
+					senderInfo.Addresses[0],
+					string(senderInfo.BridgePass)),
+				))
+
+				require.NoError(t, client.SendMail(
+					senderInfo.Addresses[0],
+					[]string{recipientInfo.Addresses[0]},
+					strings.NewReader(literal),
+				))
+			}
+
+			// By default, sending an identical message again is skipped.
+			send("Subject: Duplicate\r\n\r\nHello world!")
+			send("Subject: Duplicate\r\n\r\nHello world!")
+
+			require.IsType(t, events.SendCompleted{}, <-sendCh)
+
+			select {
+			case event := <-sendCh:
+				require.Fail(t, "duplicate message was sent", event)
+
+			case <-time.After(time.Second):
+			}
+
+			// With duplicate detection disabled, identical messages are always sent.
+			require.NoError(t, bridge.SetSendDedupEnabled(senderUserID, false))
+
+			send("Subject: Disabled\r\n\r\nHello world!")
+			send("Subject: Disabled\r\n\r\nHello world!")
+
+			event1, ok := (<-sendCh).(events.SendCompleted)
+			require.True(t, ok)
+
+			event2, ok := (<-sendCh).(events.SendCompleted)
+			require.True(t, ok)
+
+			require.NotEqual(t, event1.MessageID, event2.MessageID)
+		})
+	})
+}
@@ -22,6 +22,8 @@ import (
 	"fmt"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/userevents"
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
@@ -197,6 +199,51 @@ func (bridge *Bridge) SetShowAllMail(show bool) error {
 	}, bridge.usersLock)
 }
 
+// GetLogLevel returns the current logging level.
+func (bridge *Bridge) GetLogLevel() string {
+	return logrus.GetLevel().String()
+}
+
+// SetLogLevel changes the logging level and publishes a LoggingInfo event with the new level.
+func (bridge *Bridge) SetLogLevel(level string) error {
+	if err := logging.SetLevel(level); err != nil {
+		return fmt.Errorf("failed to set log level: %w", err)
+	}
+
+	bridge.publish(bridge.getLoggingInfo())
+
+	return nil
+}
+
+func (bridge *Bridge) getLoggingInfo() events.LoggingInfo {
+	return events.LoggingInfo{
+		Level:    bridge.GetLogLevel(),
+		FilePath: logging.CurrentLogFilePath(),
+	}
+}
+
+// GetWebSocketEvents returns whether the frontend also serves events over a local WebSocket endpoint.
+func (bridge *Bridge) GetWebSocketEvents() bool {
+	return bridge.vault.GetWebSocketEvents()
+}
+
+// SetWebSocketEvents sets whether the frontend also serves events over a local WebSocket endpoint.
+// The change takes effect the next time bridge starts.
+func (bridge *Bridge) SetWebSocketEvents(webSocketEvents bool) error {
+	return bridge.vault.SetWebSocketEvents(webSocketEvents)
+}
+
+// GetNotifiedEventTypes returns the types of events the frontend pushes to the OS notification center,
+// or nil if the frontend should use its default.
+func (bridge *Bridge) GetNotifiedEventTypes() []string {
+	return bridge.vault.GetNotifiedEventTypes()
+}
+
+// SetNotifiedEventTypes sets the types of events the frontend pushes to the OS notification center.
+func (bridge *Bridge) SetNotifiedEventTypes(eventTypes []string) error {
+	return bridge.vault.SetNotifiedEventTypes(eventTypes)
+}
+
 func (bridge *Bridge) GetAutostart() bool {
 	return bridge.vault.GetAutostart()
 }
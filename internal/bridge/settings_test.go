@@ -20,12 +20,15 @@ package bridge_test
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/go-proton-api/server"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 )
 
@@ -196,6 +199,42 @@ func TestBridge_Settings_Autostart(t *testing.T) {
 	})
 }
 
+func TestBridge_Settings_LogLevel(t *testing.T) {
+	logsDir := t.TempDir()
+
+	rotator, err := logging.NewDefaultRotator(logsDir, logging.NewSessionID(), logging.BridgeShortAppName, logging.DefaultMaxLogFileSize, -1)
+	require.NoError(t, err)
+
+	prevOutput, prevLevel := logrus.StandardLogger().Out, logrus.GetLevel()
+	logrus.SetOutput(rotator)
+
+	defer func() {
+		logrus.SetOutput(prevOutput)
+		logrus.SetLevel(prevLevel)
+		require.NoError(t, rotator.Close())
+	}()
+
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, mocks *bridge.Mocks) {
+			infoCh, done := chToType[events.Event, events.LoggingInfo](bridge.GetEvents(events.LoggingInfo{}))
+			defer done()
+
+			// Changing the level publishes the new level along with the log file.
+			require.NoError(t, bridge.SetLogLevel("warn"))
+			require.Equal(t, "warning", bridge.GetLogLevel())
+
+			info := <-infoCh
+			require.Equal(t, "warning", info.Level)
+			require.Equal(t, rotator.FilePath(), info.FilePath)
+			require.Equal(t, logsDir, filepath.Dir(info.FilePath))
+
+			// Invalid levels are rejected.
+			require.Error(t, bridge.SetLogLevel("verbose"))
+			require.Equal(t, "warning", bridge.GetLogLevel())
+		})
+	})
+}
+
 func TestBridge_Settings_FirstStart(t *testing.T) {
 	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
 		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, mocks *bridge.Mocks) {
@@ -17,6 +17,14 @@
 
 package bridge
 
+import (
+	"fmt"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/sirupsen/logrus"
+)
+
 func (bridge *Bridge) GetBridgeTLSCert() ([]byte, []byte) {
 	return bridge.vault.GetBridgeTLSCert()
 }
@@ -24,3 +32,43 @@ func (bridge *Bridge) GetBridgeTLSCert() ([]byte, []byte) {
 func (bridge *Bridge) SetBridgeTLSCertPath(certPath, keyPath string) error {
 	return bridge.vault.SetBridgeTLSCertPath(certPath, keyPath)
 }
+
+// RegenerateBridgeTLSCert generates a new bridge TLS certificate and stores it in the vault.
+// The IMAP and SMTP servers use the new certificate for new connections right away.
+// It fails with ErrCustomTLSCert if a custom certificate is in use, as it would take precedence over the new one.
+// A CertRegenerated event is published with the outcome.
+func (bridge *Bridge) RegenerateBridgeTLSCert() error {
+	fingerprint, err := bridge.regenerateBridgeTLSCert()
+	if err != nil {
+		logrus.WithError(err).Error("Failed to regenerate bridge TLS certificate")
+	} else {
+		logrus.WithField("fingerprint", fingerprint).Info("Regenerated bridge TLS certificate")
+	}
+
+	bridge.publish(events.CertRegenerated{
+		Success:     err == nil,
+		Fingerprint: fingerprint,
+	})
+
+	return err
+}
+
+func (bridge *Bridge) regenerateBridgeTLSCert() (string, error) {
+	if bridge.vault.HasCustomBridgeTLSCert() {
+		return "", ErrCustomTLSCert
+	}
+
+	certPEM, err := bridge.vault.RegenerateBridgeTLSCert()
+	if err != nil {
+		return "", err
+	}
+
+	cert, err := loadTLSCert(bridge.vault)
+	if err != nil {
+		return "", fmt.Errorf("failed to load regenerated certificate: %w", err)
+	}
+
+	bridge.tlsCert.Store(cert)
+
+	return certs.GetFingerprint(certPEM)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/sendrecorder"
 	"github.com/ProtonMail/proton-bridge/v3/internal/try"
 	"github.com/ProtonMail/proton-bridge/v3/internal/user"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
@@ -264,6 +265,11 @@ func (bridge *Bridge) DeleteUser(ctx context.Context, userID string) error {
 		return fmt.Errorf("failed to get sync config path")
 	}
 
+	sendRecorderDir, err := bridge.locator.ProvideSendRecorderPath()
+	if err != nil {
+		return fmt.Errorf("failed to get send recorder path: %w", err)
+	}
+
 	return safe.LockRet(func() error {
 		if !bridge.vault.HasUser(userID) {
 			return ErrNoSuchUser
@@ -277,6 +283,10 @@ func (bridge *Bridge) DeleteUser(ctx context.Context, userID string) error {
 			return fmt.Errorf("failed to delete use sync config")
 		}
 
+		if err := user.DeleteSendRecorderState(sendRecorderDir, userID); err != nil {
+			logrus.WithError(err).Error("Failed to delete send recorder state")
+		}
+
 		if err := bridge.vault.DeleteUser(userID); err != nil {
 			logrus.WithError(err).Error("Failed to delete vault user")
 		}
@@ -325,6 +335,54 @@ func (bridge *Bridge) SetAddressMode(ctx context.Context, userID string, mode va
 	}, bridge.usersLock)
 }
 
+// SetSendDedupEnabled sets whether duplicate sends are detected for the given user.
+func (bridge *Bridge) SetSendDedupEnabled(userID string, enabled bool) error {
+	logrus.WithField("userID", userID).WithField("enabled", enabled).Info("Setting send deduplication")
+
+	return safe.RLockRet(func() error {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		return user.SetSendDedupEnabled(enabled)
+	}, bridge.usersLock)
+}
+
+// PollNow polls the events of the given user right away rather than at the next poll period, so that new messages
+// show up sooner. Requests made in quick succession are ignored.
+//
+// It is only exposed as an API for now: IMAP IDLE does not trigger it, as gluon has no hook telling which user's
+// session started idling. Calling it on IDLE is left for when gluon provides one.
+func (bridge *Bridge) PollNow(userID string) error {
+	return safe.RLockRet(func() error {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		if !user.PollEventsNow() {
+			logrus.WithField("userID", userID).Debug("Immediate event poll already requested recently")
+		}
+
+		return nil
+	}, bridge.usersLock)
+}
+
+// GetSendRecorderState returns the sanitized entries of the send recorder of each user, keyed by user ID.
+// It is meant for diagnostic reports and never includes the recipients or the content of the messages.
+func (bridge *Bridge) GetSendRecorderState() map[string][]sendrecorder.EntrySnapshot {
+	return safe.RLockRet(func() map[string][]sendrecorder.EntrySnapshot {
+		state := make(map[string][]sendrecorder.EntrySnapshot, len(bridge.users))
+
+		for userID, user := range bridge.users {
+			state[userID] = user.SendRecorderSnapshot()
+		}
+
+		return state
+	}, bridge.usersLock)
+}
+
 // SendBadEventUserFeedback passes the feedback to the given user.
 func (bridge *Bridge) SendBadEventUserFeedback(_ context.Context, userID string, doResync bool) error {
 	logrus.WithField("userID", userID).WithField("doResync", doResync).Info("Passing bad event feedback to user")
@@ -541,6 +599,11 @@ func (bridge *Bridge) addUserWithVault(
 		return fmt.Errorf("failed to get IMAP sync config path: %w", err)
 	}
 
+	sendRecorderPath, err := bridge.locator.ProvideSendRecorderPath()
+	if err != nil {
+		return fmt.Errorf("failed to get send recorder path: %w", err)
+	}
+
 	user, err := user.New(
 		ctx,
 		vault,
@@ -557,6 +620,7 @@ func (bridge *Bridge) addUserWithVault(
 		&bridgeEventSubscription{b: bridge},
 		bridge.syncService,
 		syncSettingsPath,
+		sendRecorderPath,
 		isNew,
 	)
 	if err != nil {
@@ -612,6 +676,7 @@ func (bridge *Bridge) newVaultUser(
 // logout logs out the given user, optionally logging them out from the API too.
 func (bridge *Bridge) logoutUser(ctx context.Context, user *user.User, withAPI, withData, withTelemetry bool) {
 	defer delete(bridge.users, user.ID())
+	defer bridge.badEvents.reset(user.ID())
 
 	// if this is actually a remove account
 	if withData && withAPI {
@@ -36,6 +36,9 @@ func (bridge *Bridge) handleUserEvent(ctx context.Context, user *user.User, even
 	case events.UserBadEvent:
 		bridge.handleUserBadEvent(ctx, user, event)
 
+	case events.SyncFinished:
+		bridge.badEvents.reset(event.UserID)
+
 	case events.UncategorizedEventError:
 		bridge.handleUncategorizedErrorEvent(event)
 	}
@@ -49,6 +52,10 @@ func (bridge *Bridge) handleUserDeauth(ctx context.Context, user *user.User) {
 }
 
 func (bridge *Bridge) handleUserBadEvent(ctx context.Context, user *user.User, event events.UserBadEvent) {
+	if bridge.badEvents.onBadEvent(user.ID(), event.Error) {
+		return
+	}
+
 	safe.RLock(func() {
 		if rerr := bridge.reporter.ReportMessageWithContext("Failed to handle event", reporter.Context{
 			"user_id":      user.ID(),
@@ -65,6 +72,29 @@ func (bridge *Bridge) handleUserBadEvent(ctx context.Context, user *user.User, e
 	}, bridge.usersLock)
 }
 
+// disableUser logs out the given user, keeping its data, as done when it kept failing to handle events.
+func (bridge *Bridge) disableUser(userID string) {
+	safe.Lock(func() {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return
+		}
+
+		if rerr := bridge.reporter.ReportMessageWithContext(
+			"Failed to handle event: account disabled",
+			reporter.Context{"user_id": userID},
+		); rerr != nil {
+			logrus.WithError(rerr).Error("Failed to report account disabling")
+		}
+
+		bridge.logoutUser(context.Background(), user, false, false, false)
+
+		bridge.publish(events.UserLoggedOut{
+			UserID: userID,
+		})
+	}, bridge.usersLock)
+}
+
 func (bridge *Bridge) handleUncategorizedErrorEvent(event events.UncategorizedEventError) {
 	if rerr := bridge.reporter.ReportMessageWithContext("Failed to handle due to uncategorized error", reporter.Context{
 		"error_type": internal.ErrCauseType(event.Error),
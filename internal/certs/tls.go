@@ -21,9 +21,11 @@ import (
 	"bytes"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/hex"
 	"encoding/pem"
 	"fmt"
 	"math/big"
@@ -88,6 +90,18 @@ var GenerateCert = func(template *x509.Certificate) ([]byte, []byte, error) { //
 	return certPEM.Bytes(), keyPEM.Bytes(), nil
 }
 
+// GetFingerprint returns the hex-encoded SHA-256 fingerprint of the given PEM-encoded certificate.
+func GetFingerprint(certPEM []byte) (string, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return "", errors.New("failed to decode certificate PEM")
+	}
+
+	fingerprint := sha256.Sum256(block.Bytes)
+
+	return hex.EncodeToString(fingerprint[:]), nil
+}
+
 // GetConfig tries to load TLS config or generate new one which is then returned.
 func GetConfig(certPEM, keyPEM []byte) (*tls.Config, error) {
 	c, err := tls.X509KeyPair(certPEM, keyPEM)
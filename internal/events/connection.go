@@ -17,6 +17,11 @@
 
 package events
 
+import (
+	"fmt"
+	"time"
+)
+
 type TLSIssue struct {
 	eventBase
 }
@@ -25,6 +30,18 @@ func (event TLSIssue) String() string {
 	return "TLSIssue"
 }
 
+// CertRegenerated is published when the bridge TLS certificate has been regenerated at the user's request.
+type CertRegenerated struct {
+	eventBase
+
+	Success     bool
+	Fingerprint string
+}
+
+func (event CertRegenerated) String() string {
+	return fmt.Sprintf("CertRegenerated: Success: %t, Fingerprint: %s", event.Success, event.Fingerprint)
+}
+
 type ConnStatusUp struct {
 	eventBase
 }
@@ -40,3 +57,27 @@ type ConnStatusDown struct {
 func (event ConnStatusDown) String() string {
 	return "ConnStatusDown"
 }
+
+// ServerRateLimited is published when the API responds with 429 Too Many Requests and requests are being delayed.
+type ServerRateLimited struct {
+	eventBase
+
+	RetryAfter time.Duration
+	Endpoint   string
+}
+
+func (event ServerRateLimited) String() string {
+	return fmt.Sprintf("ServerRateLimited: RetryAfter: %0.1fs, Endpoint: %s", event.RetryAfter.Seconds(), event.Endpoint)
+}
+
+// KeyGenerationRateLimited is published when the API rate limits a key generation request.
+// The key generation is retried once RetryAfter has elapsed.
+type KeyGenerationRateLimited struct {
+	eventBase
+
+	RetryAfter time.Duration
+}
+
+func (event KeyGenerationRateLimited) String() string {
+	return fmt.Sprintf("KeyGenerationRateLimited: RetryAfter: %0.1fs", event.RetryAfter.Seconds())
+}
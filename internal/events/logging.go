@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import "fmt"
+
+// LoggingInfo is published at startup and whenever the logging level changes, to let the user know how and where
+// bridge logs. FilePath is empty if logs are not written to a file.
+type LoggingInfo struct {
+	eventBase
+
+	Level    string
+	FilePath string
+}
+
+func (event LoggingInfo) String() string {
+	return fmt.Sprintf("LoggingInfo: Level: %s, FilePath: %s", event.Level, event.FilePath)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import "fmt"
+
+// ConfigMigrated is published once bridge has attempted to migrate the config of a legacy version.
+type ConfigMigrated struct {
+	eventBase
+
+	FromVersion string
+	ToVersion   string
+
+	// Success is false if any part of the migration failed.
+	Success bool
+}
+
+func (event ConfigMigrated) String() string {
+	return fmt.Sprintf("ConfigMigrated: FromVersion: %s, ToVersion: %s, Success: %t", event.FromVersion, event.ToVersion, event.Success)
+}
@@ -17,6 +17,8 @@
 
 package events
 
+import "fmt"
+
 type Raise struct {
 	eventBase
 }
@@ -24,3 +26,15 @@ type Raise struct {
 func (event Raise) String() string {
 	return "Raise"
 }
+
+// AnotherInstanceRunning is published when another instance of the application, with the given process ID, is
+// launched while this one is running. The other instance raises this one and exits.
+type AnotherInstanceRunning struct {
+	eventBase
+
+	PID int
+}
+
+func (event AnotherInstanceRunning) String() string {
+	return fmt.Sprintf("AnotherInstanceRunning: PID: %d", event.PID)
+}
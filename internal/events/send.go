@@ -0,0 +1,123 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+)
+
+// SendCompleted is published when a message was successfully sent over SMTP.
+// Duration covers the whole send, HashDuration only the computation of the message hash used for deduplication.
+type SendCompleted struct {
+	eventBase
+
+	UserID       string
+	MessageID    string
+	Duration     time.Duration
+	HashDuration time.Duration
+	Size         int
+}
+
+func (event SendCompleted) String() string {
+	return fmt.Sprintf(
+		"SendCompleted: UserID: %s, MessageID: %s, Duration: %0.3fs, HashDuration: %0.3fs, Size: %d",
+		event.UserID,
+		event.MessageID,
+		event.Duration.Seconds(),
+		event.HashDuration.Seconds(),
+		event.Size,
+	)
+}
+
+// SentFromAddress is published after a message was successfully sent, with the address found in its From header.
+// It lets users of shared or role mailboxes keep track of which of their addresses they send from.
+type SentFromAddress struct {
+	eventBase
+
+	UserID      string
+	MessageID   string
+	FromAddress string
+}
+
+func (event SentFromAddress) String() string {
+	return fmt.Sprintf(
+		"SentFromAddress: UserID: %s, MessageID: %s, FromAddress: %s",
+		event.UserID,
+		event.MessageID,
+		logging.Sensitive(event.FromAddress),
+	)
+}
+
+// ScheduledSendDelivered is published when a message which was scheduled to be sent later has been sent by the server.
+type ScheduledSendDelivered struct {
+	eventBase
+
+	UserID    string
+	MessageID string
+}
+
+func (event ScheduledSendDelivered) String() string {
+	return fmt.Sprintf("ScheduledSendDelivered: UserID: %s, MessageID: %s", event.UserID, event.MessageID)
+}
+
+// SendPriorInProgress is published when a message could not be sent because an identical message, which may still
+// succeed, was being sent and did not complete in time. The client is asked to try again later.
+type SendPriorInProgress struct {
+	eventBase
+
+	UserID string
+}
+
+func (event SendPriorInProgress) String() string {
+	return fmt.Sprintf("SendPriorInProgress: UserID: %s", event.UserID)
+}
+
+// SendDeduped is published when a message was not sent because an identical message was already sent recently.
+type SendDeduped struct {
+	eventBase
+
+	UserID    string
+	MessageID string
+	Subject   string
+	Recipient string
+}
+
+func (event SendDeduped) String() string {
+	return fmt.Sprintf(
+		"SendDeduped: UserID: %s, MessageID: %s, Subject: %s, Recipient: %s",
+		event.UserID,
+		event.MessageID,
+		logging.Sensitive(event.Subject),
+		logging.Sensitive(event.Recipient),
+	)
+}
+
+// ShutdownPendingSends is published when bridge stopped waiting for the messages being sent on shutdown
+// while some of them were still in flight.
+type ShutdownPendingSends struct {
+	eventBase
+
+	Abandoned int
+}
+
+func (event ShutdownPendingSends) String() string {
+	return fmt.Sprintf("ShutdownPendingSends: Abandoned: %d", event.Abandoned)
+}
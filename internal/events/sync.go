@@ -32,6 +32,8 @@ func (event SyncStarted) String() string {
 	return fmt.Sprintf("SyncStarted: UserID: %s", event.UserID)
 }
 
+// SyncProgress is published periodically while the messages of a user are synchronized, either during the initial
+// sync or while handling a large batch of message events. Processed and Total count the messages or message events.
 type SyncProgress struct {
 	eventBase
 
@@ -39,15 +41,19 @@ type SyncProgress struct {
 	Progress  float64
 	Elapsed   time.Duration
 	Remaining time.Duration
+	Processed int64
+	Total     int64
 }
 
 func (event SyncProgress) String() string {
 	return fmt.Sprintf(
-		"SyncProgress: UserID: %s, Progress: %f, Elapsed: %0.1fs, Remaining: %0.1fs",
+		"SyncProgress: UserID: %s, Progress: %f, Elapsed: %0.1fs, Remaining: %0.1fs, Processed: %d, Total: %d",
 		event.UserID,
 		event.Progress,
 		event.Elapsed.Seconds(),
 		event.Remaining.Seconds(),
+		event.Processed,
+		event.Total,
 	)
 }
 
@@ -61,6 +67,53 @@ func (event SyncFinished) String() string {
 	return fmt.Sprintf("SyncFinished: UserID: %s", event.UserID)
 }
 
+// UploadProgress is published while messages appended by IMAP clients are being uploaded to the server.
+// Done is the number of messages of the current upload backlog which were processed, out of Total.
+type UploadProgress struct {
+	eventBase
+
+	UserID string
+	Done   int
+	Total  int
+}
+
+func (event UploadProgress) String() string {
+	return fmt.Sprintf("UploadProgress: UserID: %s, Done: %d, Total: %d", event.UserID, event.Done, event.Total)
+}
+
+// CorruptCacheEntry is published when the cached literal of a message could not be read and the message was fetched
+// again from the server. Recovered tells whether the message could be fetched and rebuilt.
+type CorruptCacheEntry struct {
+	eventBase
+
+	UserID    string
+	MessageID string
+	Recovered bool
+}
+
+func (event CorruptCacheEntry) String() string {
+	return fmt.Sprintf("CorruptCacheEntry: UserID: %s, MessageID: %s, Recovered: %t", event.UserID, event.MessageID, event.Recovered)
+}
+
+// MessageTruncated is published when messages are served incomplete, because some of their parts could not be decrypted
+// and are included encrypted instead. Messages served incomplete in quick succession are reported together: Count
+// tells how many, and MessageID and Reason, which tells which parts are affected, are those of the last one.
+type MessageTruncated struct {
+	eventBase
+
+	UserID    string
+	MessageID string
+	Reason    string
+	Count     int
+}
+
+func (event MessageTruncated) String() string {
+	return fmt.Sprintf(
+		"MessageTruncated: UserID: %s, MessageID: %s, Reason: %s, Count: %d",
+		event.UserID, event.MessageID, event.Reason, event.Count,
+	)
+}
+
 type SyncFailed struct {
 	eventBase
 
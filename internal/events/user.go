@@ -122,6 +122,18 @@ func (event UserBadEvent) String() string {
 	)
 }
 
+// AccountAutoDisabled is emitted when a user is logged out because it kept failing to handle events.
+type AccountAutoDisabled struct {
+	eventBase
+
+	UserID string
+	Reason string
+}
+
+func (event AccountAutoDisabled) String() string {
+	return fmt.Sprintf("AccountAutoDisabled: UserID: %s, Reason: %s", event.UserID, event.Reason)
+}
+
 // UserDeleted is emitted when a user has been deleted.
 type UserDeleted struct {
 	eventBase
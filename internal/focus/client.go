@@ -36,10 +36,20 @@ import (
 // TryRaise tries to raise the application by dialing the focus service.
 // It returns true if the service is running and the application was told to raise.
 func TryRaise(settingsPath string) bool {
+	return tryRaise(settingsPath, "TryRaise")
+}
+
+// TryRaiseFromInstance is like TryRaise, but also tells the application that another instance of it, with the given
+// process ID, was launched.
+func TryRaiseFromInstance(settingsPath string, pid int) bool {
+	return tryRaise(settingsPath, anotherInstanceReason(pid))
+}
+
+func tryRaise(settingsPath, reason string) bool {
 	var raised bool
 
 	if err := withClientConn(context.Background(), settingsPath, func(ctx context.Context, client proto.FocusClient) error {
-		if _, err := client.Raise(ctx, &wrapperspb.StringValue{Value: "TryRaise"}); err != nil {
+		if _, err := client.Raise(ctx, &wrapperspb.StringValue{Value: reason}); err != nil {
 			return fmt.Errorf("failed to call client.Raise: %w", err)
 		}
 
@@ -41,6 +41,11 @@ func TestFocus_Raise(t *testing.T) {
 	// The service should report a raise call.
 	<-service.GetRaiseCh()
 
+	// Another instance being launched is reported before the raise.
+	require.True(t, TryRaiseFromInstance(settingsFolder, 42))
+	require.Equal(t, 42, <-service.GetAnotherInstanceCh())
+	<-service.GetRaiseCh()
+
 	// Stop the service.
 	service.Close()
 
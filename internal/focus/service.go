@@ -22,6 +22,8 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/gluon/async"
@@ -36,15 +38,19 @@ import (
 const (
 	Host                 = "127.0.0.1"
 	serverConfigFileName = "grpcFocusServerConfig.json"
+
+	// anotherInstanceReasonPrefix starts the raise reason of another instance being launched, followed by its PID.
+	anotherInstanceReasonPrefix = "AnotherInstance:"
 )
 
 // Service is a gRPC service that can be used to raise the application.
 type Service struct {
 	proto.UnimplementedFocusServer
 
-	server  *grpc.Server
-	raiseCh chan struct{}
-	version *semver.Version
+	server     *grpc.Server
+	raiseCh    chan struct{}
+	instanceCh chan int
+	version    *semver.Version
 
 	log          *logrus.Entry
 	panicHandler async.PanicHandler
@@ -56,6 +62,7 @@ func NewService(locator service.Locator, version *semver.Version, panicHandler a
 	serv := &Service{
 		server:       grpc.NewServer(),
 		raiseCh:      make(chan struct{}, 1),
+		instanceCh:   make(chan int, 1),
 		version:      version,
 		log:          logrus.WithField("pkg", "focus/service"),
 		panicHandler: panicHandler,
@@ -94,6 +101,15 @@ func NewService(locator service.Locator, version *semver.Version, panicHandler a
 // Raise implements the gRPC FocusService interface; it raises the application.
 func (service *Service) Raise(_ context.Context, reason *wrapperspb.StringValue) (*emptypb.Empty, error) {
 	service.log.WithField("Reason", reason.Value).Debug("Raise")
+
+	if pid, ok := parseAnotherInstanceReason(reason.Value); ok {
+		select {
+		case service.instanceCh <- pid:
+		default:
+			service.log.WithField("pid", pid).Warn("Dropping notification of another instance being launched")
+		}
+	}
+
 	service.raiseCh <- struct{}{}
 	return &emptypb.Empty{}, nil
 }
@@ -111,6 +127,30 @@ func (service *Service) GetRaiseCh() <-chan struct{} {
 	return service.raiseCh
 }
 
+// GetAnotherInstanceCh returns a channel on which the process IDs of the other instances of the application are sent
+// when they are launched, before the application is raised.
+func (service *Service) GetAnotherInstanceCh() <-chan int {
+	return service.instanceCh
+}
+
+func anotherInstanceReason(pid int) string {
+	return anotherInstanceReasonPrefix + strconv.Itoa(pid)
+}
+
+func parseAnotherInstanceReason(reason string) (int, bool) {
+	raw, ok := strings.CutPrefix(reason, anotherInstanceReasonPrefix)
+	if !ok {
+		return 0, false
+	}
+
+	pid, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, false
+	}
+
+	return pid, true
+}
+
 // Close closes the service.
 func (service *Service) Close() {
 	go func() {
@@ -120,5 +160,6 @@ func (service *Service) Close() {
 		// interact with it in an invalid way (e.g. HTTP GET request from a Qt QNetworkManager instance).
 		service.server.Stop()
 		close(service.raiseCh)
+		close(service.instanceCh)
 	}()
 }
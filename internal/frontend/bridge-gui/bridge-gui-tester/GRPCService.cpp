@@ -94,6 +94,16 @@ Status GRPCService::GuiReady(ServerContext *, Empty const *, GuiReadyResponse *r
 }
 
 
+//****************************************************************************************************************************************************
+/// \param[in] request The request.
+/// \return The status for the call.
+//****************************************************************************************************************************************************
+Status GRPCService::SetIsGuiFocused(ServerContext *, BoolValue const *request, Empty *) {
+    app().log().debug(QString("%1 - isFocused: %2").arg(__FUNCTION__, request->value() ? "true" : "false"));
+    return Status::OK;
+}
+
+
 //****************************************************************************************************************************************************
 /// \return The status for the call.
 //****************************************************************************************************************************************************
@@ -876,7 +886,7 @@ Status GRPCService::RunEventStream(ServerContext *ctx, EventStreamRequest const
 //****************************************************************************************************************************************************
 /// \return The status for the call.
 //****************************************************************************************************************************************************
-Status GRPCService::StopEventStream(ServerContext *, Empty const *, Empty *) {
+Status GRPCService::StopEventStream(ServerContext *, StopEventStreamRequest const *, Empty *) {
     app().log().debug(__FUNCTION__);
     QMutexLocker mutex(&eventStreamMutex_);
     if (!isStreaming_) {
@@ -83,6 +83,12 @@ void QMLBackend::init(GRPCConfig const &serviceConfig) {
     eventStreamOverseer_ = std::make_unique<Overseer>(new EventStreamReader(nullptr), nullptr);
     eventStreamOverseer_->startWorker(true);
 
+    // Bridge pushes notifications to the OS notification center while the GUI is not focused.
+    app().grpc().setIsGuiFocused(qGuiApp->applicationState() == Qt::ApplicationActive);
+    connect(qGuiApp, &QGuiApplication::applicationStateChanged, this, [](Qt::ApplicationState state) {
+        app().grpc().setIsGuiFocused(state == Qt::ApplicationActive);
+    });
+
     connect(&app().log(), &Log::entryAdded, [&](Log::Level level, QString const &message) {
         app().grpc().addLogEntry(level, "frontend/bridge-gui", message);
     });
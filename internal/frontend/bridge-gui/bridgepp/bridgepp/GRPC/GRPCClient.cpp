@@ -271,6 +271,15 @@ grpc::Status GRPCClient::setIsAutostartOn(bool on) {
 }
 
 
+//****************************************************************************************************************************************************
+/// \param[in] isFocused Is the GUI focused.
+/// \return The status for the gRPC call.
+//****************************************************************************************************************************************************
+grpc::Status GRPCClient::setIsGuiFocused(bool isFocused) {
+    return this->logGRPCCallStatus(this->setBool(&Bridge::Stub::SetIsGuiFocused, isFocused), __FUNCTION__);
+}
+
+
 //****************************************************************************************************************************************************
 /// \param[out] outEnabled The new value for the property.
 /// \return The status for the gRPC call.
@@ -903,7 +912,8 @@ grpc::Status GRPCClient::stopEventStreamReader() {
     if (!this->isEventStreamActive()) {
         return Status::OK;
     }
-    return this->logGRPCCallStatus(stub_->StopEventStream(this->clientContext().get(), empty, &empty), __FUNCTION__);
+    StopEventStreamRequest request; // An empty stream ID stops all the event streams.
+    return this->logGRPCCallStatus(stub_->StopEventStream(this->clientContext().get(), request, &empty), __FUNCTION__);
 }
 
 
@@ -416,6 +416,9 @@ func (f *frontendCLI) watchEvents(eventCh <-chan events.Event) { // nolint:gocyc
 			f.Printf("* bad-event synchronize\n")
 			f.Printf("* bad-event logout\n\n")
 
+		case events.AccountAutoDisabled:
+			f.Printf("Account %v was logged out as it kept failing to handle events: %v\n", event.UserID, event.Reason)
+
 		case events.IMAPLoginFailed:
 			f.Printf("An IMAP login attempt failed for user %v\n", event.Username)
 
@@ -28,6 +28,9 @@ type BridgeClient interface {
 	CheckTokens(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	AddLogEntry(ctx context.Context, in *AddLogEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GuiReady(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GuiReadyResponse, error)
+	SetIsGuiFocused(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetNotifiedEventTypes(ctx context.Context, in *NotifiedEventTypeList, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	NotifiedEventTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NotifiedEventTypeList, error)
 	Quit(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Restart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ShowOnStartup(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
@@ -39,6 +42,10 @@ type BridgeClient interface {
 	IsAllMailVisible(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	SetIsTelemetryDisabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	IsTelemetryDisabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	SetIsWebSocketEventsEnabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	IsWebSocketEventsEnabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	SetLogLevel(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CurrentLogLevel(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	GoOs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	TriggerReset(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
@@ -51,6 +58,7 @@ type BridgeClient interface {
 	ColorSchemeName(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	CurrentEmailClient(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	ReportBug(ctx context.Context, in *ReportBugRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSendRecorderState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SendRecorderStateResponse, error)
 	ForceLauncher(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetMainExecutable(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// login
@@ -93,9 +101,12 @@ type BridgeClient interface {
 	IsTLSCertificateInstalled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	InstallTLSCertificate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ExportTLSCertificates(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RegenerateTLSCertificate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Server -> Client event stream
 	RunEventStream(ctx context.Context, in *EventStreamRequest, opts ...grpc.CallOption) (Bridge_RunEventStreamClient, error)
-	StopEventStream(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StopEventStream(ctx context.Context, in *StopEventStreamRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListActiveStreams(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ActiveStreamList, error)
+	AckEvent(ctx context.Context, in *AckEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type bridgeClient struct {
@@ -133,6 +144,33 @@ func (c *bridgeClient) GuiReady(ctx context.Context, in *emptypb.Empty, opts ...
 	return out, nil
 }
 
+func (c *bridgeClient) SetIsGuiFocused(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/SetIsGuiFocused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetNotifiedEventTypes(ctx context.Context, in *NotifiedEventTypeList, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/SetNotifiedEventTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) NotifiedEventTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NotifiedEventTypeList, error) {
+	out := new(NotifiedEventTypeList)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/NotifiedEventTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) Quit(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/grpc.Bridge/Quit", in, out, opts...)
@@ -232,6 +270,42 @@ func (c *bridgeClient) IsTelemetryDisabled(ctx context.Context, in *emptypb.Empt
 	return out, nil
 }
 
+func (c *bridgeClient) SetIsWebSocketEventsEnabled(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/SetIsWebSocketEventsEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) IsWebSocketEventsEnabled(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/IsWebSocketEventsEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetLogLevel(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) CurrentLogLevel(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/CurrentLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) GoOs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
 	out := new(wrapperspb.StringValue)
 	err := c.cc.Invoke(ctx, "/grpc.Bridge/GoOs", in, out, opts...)
@@ -340,6 +414,15 @@ func (c *bridgeClient) ReportBug(ctx context.Context, in *ReportBugRequest, opts
 	return out, nil
 }
 
+func (c *bridgeClient) GetSendRecorderState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SendRecorderStateResponse, error) {
+	out := new(SendRecorderStateResponse)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/GetSendRecorderState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) ForceLauncher(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/grpc.Bridge/ForceLauncher", in, out, opts...)
@@ -646,6 +729,15 @@ func (c *bridgeClient) ExportTLSCertificates(ctx context.Context, in *wrapperspb
 	return out, nil
 }
 
+func (c *bridgeClient) RegenerateTLSCertificate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/RegenerateTLSCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) RunEventStream(ctx context.Context, in *EventStreamRequest, opts ...grpc.CallOption) (Bridge_RunEventStreamClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Bridge_ServiceDesc.Streams[0], "/grpc.Bridge/RunEventStream", opts...)
 	if err != nil {
@@ -678,7 +770,7 @@ func (x *bridgeRunEventStreamClient) Recv() (*StreamEvent, error) {
 	return m, nil
 }
 
-func (c *bridgeClient) StopEventStream(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bridgeClient) StopEventStream(ctx context.Context, in *StopEventStreamRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/grpc.Bridge/StopEventStream", in, out, opts...)
 	if err != nil {
@@ -687,6 +779,24 @@ func (c *bridgeClient) StopEventStream(ctx context.Context, in *emptypb.Empty, o
 	return out, nil
 }
 
+func (c *bridgeClient) ListActiveStreams(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ActiveStreamList, error) {
+	out := new(ActiveStreamList)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/ListActiveStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) AckEvent(ctx context.Context, in *AckEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/AckEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BridgeServer is the server API for Bridge service.
 // All implementations must embed UnimplementedBridgeServer
 // for forward compatibility
@@ -695,6 +805,9 @@ type BridgeServer interface {
 	CheckTokens(context.Context, *wrapperspb.StringValue) (*wrapperspb.StringValue, error)
 	AddLogEntry(context.Context, *AddLogEntryRequest) (*emptypb.Empty, error)
 	GuiReady(context.Context, *emptypb.Empty) (*GuiReadyResponse, error)
+	SetIsGuiFocused(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
+	SetNotifiedEventTypes(context.Context, *NotifiedEventTypeList) (*emptypb.Empty, error)
+	NotifiedEventTypes(context.Context, *emptypb.Empty) (*NotifiedEventTypeList, error)
 	Quit(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	Restart(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	ShowOnStartup(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
@@ -706,6 +819,10 @@ type BridgeServer interface {
 	IsAllMailVisible(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
 	SetIsTelemetryDisabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
 	IsTelemetryDisabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	SetIsWebSocketEventsEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
+	IsWebSocketEventsEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	SetLogLevel(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	CurrentLogLevel(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
 	GoOs(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
 	TriggerReset(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	Version(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
@@ -718,6 +835,7 @@ type BridgeServer interface {
 	ColorSchemeName(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
 	CurrentEmailClient(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
 	ReportBug(context.Context, *ReportBugRequest) (*emptypb.Empty, error)
+	GetSendRecorderState(context.Context, *emptypb.Empty) (*SendRecorderStateResponse, error)
 	ForceLauncher(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
 	SetMainExecutable(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
 	// login
@@ -760,9 +878,12 @@ type BridgeServer interface {
 	IsTLSCertificateInstalled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
 	InstallTLSCertificate(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	ExportTLSCertificates(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	RegenerateTLSCertificate(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// Server -> Client event stream
 	RunEventStream(*EventStreamRequest, Bridge_RunEventStreamServer) error
-	StopEventStream(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	StopEventStream(context.Context, *StopEventStreamRequest) (*emptypb.Empty, error)
+	ListActiveStreams(context.Context, *emptypb.Empty) (*ActiveStreamList, error)
+	AckEvent(context.Context, *AckEventRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedBridgeServer()
 }
 
@@ -779,6 +900,15 @@ func (UnimplementedBridgeServer) AddLogEntry(context.Context, *AddLogEntryReques
 func (UnimplementedBridgeServer) GuiReady(context.Context, *emptypb.Empty) (*GuiReadyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GuiReady not implemented")
 }
+func (UnimplementedBridgeServer) SetIsGuiFocused(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsGuiFocused not implemented")
+}
+func (UnimplementedBridgeServer) SetNotifiedEventTypes(context.Context, *NotifiedEventTypeList) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotifiedEventTypes not implemented")
+}
+func (UnimplementedBridgeServer) NotifiedEventTypes(context.Context, *emptypb.Empty) (*NotifiedEventTypeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifiedEventTypes not implemented")
+}
 func (UnimplementedBridgeServer) Quit(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Quit not implemented")
 }
@@ -812,6 +942,18 @@ func (UnimplementedBridgeServer) SetIsTelemetryDisabled(context.Context, *wrappe
 func (UnimplementedBridgeServer) IsTelemetryDisabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsTelemetryDisabled not implemented")
 }
+func (UnimplementedBridgeServer) SetIsWebSocketEventsEnabled(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsWebSocketEventsEnabled not implemented")
+}
+func (UnimplementedBridgeServer) IsWebSocketEventsEnabled(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsWebSocketEventsEnabled not implemented")
+}
+func (UnimplementedBridgeServer) SetLogLevel(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedBridgeServer) CurrentLogLevel(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentLogLevel not implemented")
+}
 func (UnimplementedBridgeServer) GoOs(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GoOs not implemented")
 }
@@ -848,6 +990,9 @@ func (UnimplementedBridgeServer) CurrentEmailClient(context.Context, *emptypb.Em
 func (UnimplementedBridgeServer) ReportBug(context.Context, *ReportBugRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportBug not implemented")
 }
+func (UnimplementedBridgeServer) GetSendRecorderState(context.Context, *emptypb.Empty) (*SendRecorderStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSendRecorderState not implemented")
+}
 func (UnimplementedBridgeServer) ForceLauncher(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceLauncher not implemented")
 }
@@ -950,12 +1095,21 @@ func (UnimplementedBridgeServer) InstallTLSCertificate(context.Context, *emptypb
 func (UnimplementedBridgeServer) ExportTLSCertificates(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportTLSCertificates not implemented")
 }
+func (UnimplementedBridgeServer) RegenerateTLSCertificate(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateTLSCertificate not implemented")
+}
 func (UnimplementedBridgeServer) RunEventStream(*EventStreamRequest, Bridge_RunEventStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method RunEventStream not implemented")
 }
-func (UnimplementedBridgeServer) StopEventStream(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+func (UnimplementedBridgeServer) StopEventStream(context.Context, *StopEventStreamRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopEventStream not implemented")
 }
+func (UnimplementedBridgeServer) ListActiveStreams(context.Context, *emptypb.Empty) (*ActiveStreamList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActiveStreams not implemented")
+}
+func (UnimplementedBridgeServer) AckEvent(context.Context, *AckEventRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckEvent not implemented")
+}
 func (UnimplementedBridgeServer) mustEmbedUnimplementedBridgeServer() {}
 
 // UnsafeBridgeServer may be embedded to opt out of forward compatibility for this service.
@@ -1023,6 +1177,60 @@ func _Bridge_GuiReady_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bridge_SetIsGuiFocused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BoolValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetIsGuiFocused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/SetIsGuiFocused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetIsGuiFocused(ctx, req.(*wrapperspb.BoolValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetNotifiedEventTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifiedEventTypeList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetNotifiedEventTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/SetNotifiedEventTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetNotifiedEventTypes(ctx, req.(*NotifiedEventTypeList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_NotifiedEventTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).NotifiedEventTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/NotifiedEventTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).NotifiedEventTypes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bridge_Quit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1221,6 +1429,78 @@ func _Bridge_IsTelemetryDisabled_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bridge_SetIsWebSocketEventsEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BoolValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetIsWebSocketEventsEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/SetIsWebSocketEventsEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetIsWebSocketEventsEnabled(ctx, req.(*wrapperspb.BoolValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_IsWebSocketEventsEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).IsWebSocketEventsEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/IsWebSocketEventsEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).IsWebSocketEventsEnabled(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetLogLevel(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_CurrentLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).CurrentLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/CurrentLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).CurrentLogLevel(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bridge_GoOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1437,6 +1717,24 @@ func _Bridge_ReportBug_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bridge_GetSendRecorderState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).GetSendRecorderState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/GetSendRecorderState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).GetSendRecorderState(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bridge_ForceLauncher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
@@ -2049,6 +2347,24 @@ func _Bridge_ExportTLSCertificates_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bridge_RegenerateTLSCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).RegenerateTLSCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/RegenerateTLSCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).RegenerateTLSCertificate(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bridge_RunEventStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(EventStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2071,7 +2387,7 @@ func (x *bridgeRunEventStreamServer) Send(m *StreamEvent) error {
 }
 
 func _Bridge_StopEventStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(StopEventStreamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2083,7 +2399,43 @@ func _Bridge_StopEventStream_Handler(srv interface{}, ctx context.Context, dec f
 		FullMethod: "/grpc.Bridge/StopEventStream",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).StopEventStream(ctx, req.(*emptypb.Empty))
+		return srv.(BridgeServer).StopEventStream(ctx, req.(*StopEventStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_ListActiveStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).ListActiveStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/ListActiveStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).ListActiveStreams(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_AckEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).AckEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/AckEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).AckEvent(ctx, req.(*AckEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2107,6 +2459,18 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GuiReady",
 			Handler:    _Bridge_GuiReady_Handler,
 		},
+		{
+			MethodName: "SetIsGuiFocused",
+			Handler:    _Bridge_SetIsGuiFocused_Handler,
+		},
+		{
+			MethodName: "SetNotifiedEventTypes",
+			Handler:    _Bridge_SetNotifiedEventTypes_Handler,
+		},
+		{
+			MethodName: "NotifiedEventTypes",
+			Handler:    _Bridge_NotifiedEventTypes_Handler,
+		},
 		{
 			MethodName: "Quit",
 			Handler:    _Bridge_Quit_Handler,
@@ -2151,6 +2515,22 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsTelemetryDisabled",
 			Handler:    _Bridge_IsTelemetryDisabled_Handler,
 		},
+		{
+			MethodName: "SetIsWebSocketEventsEnabled",
+			Handler:    _Bridge_SetIsWebSocketEventsEnabled_Handler,
+		},
+		{
+			MethodName: "IsWebSocketEventsEnabled",
+			Handler:    _Bridge_IsWebSocketEventsEnabled_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Bridge_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "CurrentLogLevel",
+			Handler:    _Bridge_CurrentLogLevel_Handler,
+		},
 		{
 			MethodName: "GoOs",
 			Handler:    _Bridge_GoOs_Handler,
@@ -2199,6 +2579,10 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportBug",
 			Handler:    _Bridge_ReportBug_Handler,
 		},
+		{
+			MethodName: "GetSendRecorderState",
+			Handler:    _Bridge_GetSendRecorderState_Handler,
+		},
 		{
 			MethodName: "ForceLauncher",
 			Handler:    _Bridge_ForceLauncher_Handler,
@@ -2335,10 +2719,22 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportTLSCertificates",
 			Handler:    _Bridge_ExportTLSCertificates_Handler,
 		},
+		{
+			MethodName: "RegenerateTLSCertificate",
+			Handler:    _Bridge_RegenerateTLSCertificate_Handler,
+		},
 		{
 			MethodName: "StopEventStream",
 			Handler:    _Bridge_StopEventStream_Handler,
 		},
+		{
+			MethodName: "ListActiveStreams",
+			Handler:    _Bridge_ListActiveStreams_Handler,
+		},
+		{
+			MethodName: "AckEvent",
+			Handler:    _Bridge_AckEvent_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
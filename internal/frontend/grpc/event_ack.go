@@ -0,0 +1,179 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	defaultEventAckTimeout = 10 * time.Second // How long by default a client has to acknowledge a critical event.
+	maxEventAckAttempts    = 3                // How many times a critical event is sent before giving up on its acknowledgement.
+)
+
+// ackedEventTypes are the types of the critical events which clients are expected to acknowledge with AckEvent. The
+// other events are fire-and-forget.
+var ackedEventTypes = map[string]struct{}{ //nolint:gochecknoglobals
+	"UpdateForceEvent":          {},
+	"AddressChangedLogoutEvent": {},
+	"UserBadEvent":              {},
+	"AccountAutoDisabledEvent":  {},
+}
+
+// pendingAck is a critical event which was not acknowledged yet.
+type pendingAck struct {
+	event    *StreamEvent
+	attempts int
+	timer    *time.Timer
+}
+
+// eventAcks holds the critical events which were not acknowledged yet, by sequence.
+type eventAcks struct {
+	lock    sync.Mutex
+	pending map[uint64]*pendingAck
+}
+
+// AckEvent confirms that the client handled the event with the given sequence. Only critical events, such as
+// UpdateForceEvent, need to be acknowledged; they are sent again to the open streams until they are.
+func (s *Service) AckEvent(_ context.Context, request *AckEventRequest) (*emptypb.Empty, error) {
+	s.eventAcks.lock.Lock()
+	defer s.eventAcks.lock.Unlock()
+
+	pending, ok := s.eventAcks.pending[request.Sequence]
+	if !ok {
+		s.log.WithField("sequence", request.Sequence).Debug("Acknowledged event is not pending")
+		return &emptypb.Empty{}, nil
+	}
+
+	pending.timer.Stop()
+	delete(s.eventAcks.pending, request.Sequence)
+
+	return &emptypb.Empty{}, nil
+}
+
+// SetEventAckTimeout sets how long clients have to acknowledge a critical event before it is sent again. A non-positive
+// timeout selects the default.
+func (s *Service) SetEventAckTimeout(timeout time.Duration) {
+	s.eventAckTimeout.Store(int64(timeout))
+}
+
+// requiresAck returns whether clients are expected to acknowledge the given event.
+func requiresAck(event *StreamEvent) bool {
+	_, ok := ackedEventTypes[eventType(event)]
+	return ok
+}
+
+// trackEventAck waits for the given event, which must have a sequence, to be acknowledged if it is critical.
+func (s *Service) trackEventAck(event *StreamEvent) {
+	if !requiresAck(event) {
+		return
+	}
+
+	s.eventAcks.lock.Lock()
+	defer s.eventAcks.lock.Unlock()
+
+	if s.eventAcks.pending == nil {
+		s.eventAcks.pending = make(map[uint64]*pendingAck)
+	}
+
+	sequence := event.Sequence
+
+	s.eventAcks.pending[sequence] = &pendingAck{
+		event:    event,
+		attempts: 1,
+		timer:    time.AfterFunc(s.getEventAckTimeout(), func() { s.onEventAckTimeout(sequence) }),
+	}
+}
+
+// onEventAckTimeout sends the critical event with the given sequence again to the open streams, if it is still not
+// acknowledged, until it was sent maxEventAckAttempts times. Events waiting in the queue for a stream to open are not
+// counted as sent.
+func (s *Service) onEventAckTimeout(sequence uint64) {
+	s.eventAcks.lock.Lock()
+
+	pending, ok := s.eventAcks.pending[sequence]
+	if !ok {
+		s.eventAcks.lock.Unlock()
+		return
+	}
+
+	if pending.attempts >= maxEventAckAttempts {
+		delete(s.eventAcks.pending, sequence)
+		s.eventAcks.lock.Unlock()
+
+		s.log.WithField("sequence", sequence).WithField("event", pending.event).Warn("Critical event was never acknowledged")
+
+		return
+	}
+
+	streams := s.getActiveStreams()
+	if len(streams) > 0 {
+		pending.attempts++
+	}
+
+	pending.timer = time.AfterFunc(s.getEventAckTimeout(), func() { s.onEventAckTimeout(sequence) })
+
+	s.eventAcks.lock.Unlock()
+
+	if len(streams) == 0 {
+		return
+	}
+
+	s.log.WithField("sequence", sequence).Warn("Critical event was not acknowledged, sending it again")
+
+	timeout := time.Duration(s.eventSendTimeout.Load())
+	if timeout <= 0 {
+		timeout = defaultEventStreamSendTimeout
+	}
+
+	for _, stream := range streams {
+		// Legacy clients don't know event sequences, and thus can't acknowledge events.
+		if stream.protocolVersion < eventProtocolV2 {
+			continue
+		}
+
+		if err := stream.send(context.Background(), pending.event, EventSendPolicy(s.eventSendPolicy.Load()), timeout); err != nil {
+			s.log.WithError(err).WithField("stream", stream.id).Warn("Failed to send critical event again")
+		}
+	}
+}
+
+// getPendingEventAcks returns the sequences of the critical events which were not acknowledged yet, in order.
+func (s *Service) getPendingEventAcks() []uint64 {
+	s.eventAcks.lock.Lock()
+	defer s.eventAcks.lock.Unlock()
+
+	sequences := maps.Keys(s.eventAcks.pending)
+	slices.Sort(sequences)
+
+	return sequences
+}
+
+func (s *Service) getEventAckTimeout() time.Duration {
+	if timeout := time.Duration(s.eventAckTimeout.Load()); timeout > 0 {
+		return timeout
+	}
+
+	return defaultEventAckTimeout
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_EventSequenceIncreases(t *testing.T) {
+	s := newTestService()
+
+	server, errCh := startTestEventStream(t, s)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, s.SendEvent(NewShowMainWindowEvent()))
+	}
+
+	require.Eventually(t, func() bool { return len(server.received()) == 3 }, time.Second, time.Millisecond)
+
+	for i, event := range server.received() {
+		require.Equal(t, uint64(i+1), event.GetSequence())
+	}
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_AckEventClearsPending(t *testing.T) {
+	s := newTestService()
+	s.SetEventAckTimeout(time.Hour)
+
+	server, errCh := startTestEventStream(t, s)
+
+	// Only critical events need to be acknowledged.
+	require.NoError(t, s.SendEvent(NewShowMainWindowEvent()))
+	require.NoError(t, s.SendEvent(NewUpdateForceEvent("2.0")))
+	require.NoError(t, s.SendEvent(NewUserBadEvent("userID", "error")))
+
+	require.Eventually(t, func() bool { return len(server.received()) == 3 }, time.Second, time.Millisecond)
+	require.Equal(t, []uint64{2, 3}, s.getPendingEventAcks())
+
+	_, err := s.AckEvent(context.Background(), &AckEventRequest{Sequence: 2})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, s.getPendingEventAcks())
+
+	// Acknowledging an event twice, or one which does not need it, is harmless.
+	for _, sequence := range []uint64{1, 2, 3} {
+		_, err := s.AckEvent(context.Background(), &AckEventRequest{Sequence: sequence})
+		require.NoError(t, err)
+	}
+
+	require.Empty(t, s.getPendingEventAcks())
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_UnackedEventIsSentAgain(t *testing.T) {
+	s := newTestService()
+	s.SetEventAckTimeout(10 * time.Millisecond)
+
+	server, errCh := startTestEventStream(t, s)
+
+	require.NoError(t, s.SendEvent(NewUpdateForceEvent("2.0")))
+
+	// The event is sent until the maximum number of attempts, then given up on.
+	require.Eventually(t, func() bool { return len(s.getPendingEventAcks()) == 0 }, time.Second, time.Millisecond)
+
+	require.Eventually(t, func() bool { return len(server.received()) == maxEventAckAttempts }, time.Second, time.Millisecond)
+
+	received := server.received()
+
+	for _, event := range received {
+		require.Equal(t, "2.0", event.GetUpdate().GetForce().GetVersion())
+		require.Equal(t, uint64(1), event.GetSequence())
+	}
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// eventDebouncer holds back the high-frequency events sent in quick succession, such as user changes during a sync,
+// so that only the latest event of each coalesce key is sent once the debounce interval has elapsed since the first.
+// Events of distinct users or addresses have distinct keys and are never coalesced together.
+type eventDebouncer struct {
+	pending map[string]*StreamEvent
+	lock    sync.Mutex
+}
+
+// SetEventDebounceInterval sets for how long the high-frequency events are held back, so that only the latest of the
+// events with the same key sent in the meantime is sent. A non-positive interval, the default, disables debouncing.
+func (s *Service) SetEventDebounceInterval(interval time.Duration) {
+	s.eventDebounceInterval.Store(int64(interval))
+}
+
+// debounceEvent holds back the given event, and returns false if the event should be sent right away instead.
+func (s *Service) debounceEvent(event *StreamEvent) bool {
+	interval := time.Duration(s.eventDebounceInterval.Load())
+	if interval <= 0 {
+		return false
+	}
+
+	key, ok := coalesceKey(event)
+	if !ok {
+		return false
+	}
+
+	s.eventDebouncer.lock.Lock()
+	defer s.eventDebouncer.lock.Unlock()
+
+	if s.eventDebouncer.pending == nil {
+		s.eventDebouncer.pending = make(map[string]*StreamEvent)
+	}
+
+	if _, ok := s.eventDebouncer.pending[key]; !ok {
+		time.AfterFunc(interval, func() { s.sendDebouncedEvent(key) })
+	}
+
+	s.eventDebouncer.pending[key] = event
+
+	return true
+}
+
+// sendDebouncedEvent sends the latest event held back for the given key.
+func (s *Service) sendDebouncedEvent(key string) {
+	s.eventDebouncer.lock.Lock()
+	event, ok := s.eventDebouncer.pending[key]
+	delete(s.eventDebouncer.pending, key)
+	s.eventDebouncer.lock.Unlock()
+
+	if !ok {
+		return
+	}
+
+	if err := s.sendEvent(context.Background(), event); err != nil {
+		s.log.WithError(err).Warn("Failed to send debounced event")
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_EventDebounce(t *testing.T) {
+	s := newTestService()
+	s.SetEventDebounceInterval(100 * time.Millisecond)
+
+	server, errCh := startTestEventStream(t, s)
+
+	// Rapid events with the same key collapse into the latest one.
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, s.SendEvent(NewUsedBytesChangedEvent("userID1", uint64(i))))
+	}
+
+	// Events with distinct keys are not coalesced together.
+	require.NoError(t, s.SendEvent(NewUsedBytesChangedEvent("userID2", 10)))
+	require.NoError(t, s.SendEvent(NewMailAddressChangeEvent("a@pm.me")))
+	require.NoError(t, s.SendEvent(NewMailAddressChangeEvent("b@pm.me")))
+
+	// Other events are sent right away.
+	require.NoError(t, s.SendEvent(NewShowMainWindowEvent()))
+	require.Eventually(t, func() bool { return len(server.received()) == 1 }, time.Second, time.Millisecond)
+	require.NotNil(t, server.received()[0].GetApp().GetShowMainWindow())
+
+	require.Eventually(t, func() bool { return len(server.received()) == 5 }, time.Second, time.Millisecond)
+
+	usedBytes := make(map[string]int64)
+	addresses := make(map[string]bool)
+
+	for _, event := range server.received()[1:] {
+		if changed := event.GetUser().GetUsedBytesChangedEvent(); changed != nil {
+			usedBytes[changed.GetUserID()] = changed.GetUsedBytes()
+		} else {
+			addresses[event.GetMail().GetAddressChanged().GetAddress()] = true
+		}
+	}
+
+	require.Equal(t, map[string]int64{"userID1": 3, "userID2": 10}, usedBytes)
+	require.Equal(t, map[string]bool{"a@pm.me": true, "b@pm.me": true}, addresses)
+
+	// Nothing else is sent afterwards.
+	time.Sleep(200 * time.Millisecond)
+	require.Len(t, server.received(), 5)
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_EventDebounceDisabled(t *testing.T) {
+	s := newTestService()
+
+	server, errCh := startTestEventStream(t, s)
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, s.SendEvent(NewUsedBytesChangedEvent("userID", uint64(i))))
+	}
+
+	require.Eventually(t, func() bool { return len(server.received()) == 3 }, time.Second, time.Millisecond)
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
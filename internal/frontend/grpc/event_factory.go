@@ -17,6 +17,8 @@
 
 package grpc
 
+import "time"
+
 func NewInternetStatusEvent(connected bool) *StreamEvent {
 	return appEvent(&AppEvent{Event: &AppEvent_InternetStatus{InternetStatus: &InternetStatusEvent{Connected: connected}}})
 }
@@ -57,6 +59,62 @@ func NewCertInstallFailedEvent() *StreamEvent {
 	return appEvent(&AppEvent{Event: &AppEvent_CertificateInstallFailed{CertificateInstallFailed: &CertificateInstallFailedEvent{}}})
 }
 
+func NewCertRegeneratedEvent(success bool, fingerprint string) *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_CertificateRegenerated{CertificateRegenerated: &CertificateRegeneratedEvent{
+		Success:     success,
+		Fingerprint: fingerprint,
+	}}})
+}
+
+func NewConfigMigratedEvent(fromVersion, toVersion string, success bool) *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_ConfigMigrated{ConfigMigrated: &ConfigMigratedEvent{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Success:     success,
+	}}})
+}
+
+func NewAnotherInstanceRunningEvent(pid int) *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_AnotherInstanceRunning{AnotherInstanceRunning: &AnotherInstanceRunningEvent{Pid: int32(pid)}}})
+}
+
+func NewEventsDroppedEvent(count int) *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_EventsDropped{EventsDropped: &EventsDroppedEvent{Count: int32(count)}}})
+}
+
+func NewServerRateLimitedEvent(retryAfter time.Duration, endpoint string) *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_ServerRateLimited{ServerRateLimited: &ServerRateLimitedEvent{
+		RetryAfterMs: retryAfter.Milliseconds(),
+		Endpoint:     endpoint,
+	}}})
+}
+
+func NewKeyGenerationRateLimitedEvent(retryAfter time.Duration) *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_KeyGenerationRateLimited{KeyGenerationRateLimited: &KeyGenerationRateLimitedEvent{
+		RetryAfterMs: retryAfter.Milliseconds(),
+	}}})
+}
+
+func NewLoggingInfoEvent(level, filePath string) *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_LoggingInfo{LoggingInfo: &LoggingInfoEvent{Level: level, FilePath: filePath}}})
+}
+
+func NewHeartbeatEvent() *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_Heartbeat{Heartbeat: &HeartbeatEvent{}}})
+}
+
+func NewResyncRequiredEvent() *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_ResyncRequired{ResyncRequired: &ResyncRequiredEvent{}}})
+}
+
+func NewShutdownPendingSendsEvent(abandoned int) *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_ShutdownPendingSends{ShutdownPendingSends: &ShutdownPendingSendsEvent{Abandoned: int32(abandoned)}}})
+}
+
+func NewStreamEndingEvent(reason string) *StreamEvent {
+	return appEvent(&AppEvent{Event: &AppEvent_StreamEnding{StreamEnding: &StreamEndingEvent{Reason: reason}}})
+}
+
 func NewShowMainWindowEvent() *StreamEvent {
 	return appEvent(&AppEvent{Event: &AppEvent_ShowMainWindow{ShowMainWindow: &ShowMainWindowEvent{}}})
 }
@@ -177,6 +235,53 @@ func NewMailApiCertIssue() *StreamEvent { //nolint:revive,stylecheck
 	return mailEvent(&MailEvent{Event: &MailEvent_ApiCertIssue{ApiCertIssue: &ApiCertIssueEvent{}}})
 }
 
+func NewMailSendCompletedEvent(userID, messageID string, durationMs, sizeBytes, hashDurationMs int64) *StreamEvent {
+	return mailEvent(&MailEvent{Event: &MailEvent_SendCompleted{SendCompleted: &SendCompletedEvent{
+		UserID:         userID,
+		MessageID:      messageID,
+		DurationMs:     durationMs,
+		SizeBytes:      sizeBytes,
+		HashDurationMs: hashDurationMs,
+	}}})
+}
+
+func NewMailSendPriorInProgressEvent(userID string) *StreamEvent {
+	return mailEvent(&MailEvent{Event: &MailEvent_SendPriorInProgress{SendPriorInProgress: &SendPriorInProgressEvent{UserID: userID}}})
+}
+
+func NewMailScheduledSendDeliveredEvent(userID, messageID string) *StreamEvent {
+	return mailEvent(&MailEvent{Event: &MailEvent_ScheduledSendDelivered{ScheduledSendDelivered: &ScheduledSendDeliveredEvent{
+		UserID:    userID,
+		MessageID: messageID,
+	}}})
+}
+
+func NewMailSentFromAddressEvent(userID, messageID, fromAddress string) *StreamEvent {
+	return mailEvent(&MailEvent{Event: &MailEvent_SentFromAddress{SentFromAddress: &SentFromAddressEvent{
+		UserID:      userID,
+		MessageID:   messageID,
+		FromAddress: fromAddress,
+	}}})
+}
+
+func NewMailMessageTruncatedEvent(userID, messageID, reason string, count int) *StreamEvent {
+	return mailEvent(&MailEvent{Event: &MailEvent_MessageTruncated{MessageTruncated: &MessageTruncatedEvent{
+		UserID:    userID,
+		MessageID: messageID,
+		Reason:    reason,
+		Count:     int32(count),
+	}}})
+}
+
+func NewMailSendDedupedEvent(userID, messageID, subject, recipient string) *StreamEvent {
+	return mailEvent(&MailEvent{Event: &MailEvent_SendDeduped{SendDeduped: &SendDedupedEvent{
+		UserID:    userID,
+		MessageID: messageID,
+		Subject:   subject,
+		Recipient: recipient,
+	}}})
+}
+
 func NewUserToggleSplitModeFinishedEvent(userID string) *StreamEvent {
 	return userEvent(&UserEvent{Event: &UserEvent_ToggleSplitModeFinished{ToggleSplitModeFinished: &ToggleSplitModeFinishedEvent{UserID: userID}}})
 }
@@ -209,12 +314,37 @@ func NewSyncFinishedEvent(userID string) *StreamEvent {
 	return userEvent(&UserEvent{Event: &UserEvent_SyncFinishedEvent{SyncFinishedEvent: &SyncFinishedEvent{UserID: userID}}})
 }
 
-func NewSyncProgressEvent(userID string, progress float64, elapsedMs, remainingMs int64) *StreamEvent {
+func NewSyncProgressEvent(userID string, progress float64, elapsedMs, remainingMs, processed, total int64) *StreamEvent {
 	return userEvent(&UserEvent{Event: &UserEvent_SyncProgressEvent{SyncProgressEvent: &SyncProgressEvent{
 		UserID:      userID,
 		Progress:    progress,
 		ElapsedMs:   elapsedMs,
 		RemainingMs: remainingMs,
+		Processed:   processed,
+		Total:       total,
+	}}})
+}
+
+func NewUploadProgressEvent(userID string, done, total int) *StreamEvent {
+	return userEvent(&UserEvent{Event: &UserEvent_UploadProgressEvent{UploadProgressEvent: &UploadProgressEvent{
+		UserID: userID,
+		Done:   int32(done),
+		Total:  int32(total),
+	}}})
+}
+
+func NewCorruptCacheEntryEvent(userID, messageID string, recovered bool) *StreamEvent {
+	return userEvent(&UserEvent{Event: &UserEvent_CorruptCacheEntryEvent{CorruptCacheEntryEvent: &CorruptCacheEntryEvent{
+		UserID:    userID,
+		MessageID: messageID,
+		Recovered: recovered,
+	}}})
+}
+
+func NewAccountAutoDisabledEvent(userID, reason string) *StreamEvent {
+	return userEvent(&UserEvent{Event: &UserEvent_AccountAutoDisabledEvent{AccountAutoDisabledEvent: &AccountAutoDisabledEvent{
+		UserID: userID,
+		Reason: reason,
 	}}})
 }
 
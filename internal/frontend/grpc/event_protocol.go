@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The versions of the event stream protocol, negotiated with the protocolVersion of EventStreamRequest.
+const (
+	// eventProtocolV1 is the legacy protocol: events have neither metadata nor sequence, and only the events known to
+	// the first frontends are sent.
+	eventProtocolV1 uint32 = 1
+
+	// eventProtocolV2 adds the metadata and sequence of events, the stream control events such as heartbeats, and the
+	// events added since version 1.
+	eventProtocolV2 uint32 = 2
+
+	eventProtocolLatest = eventProtocolV2
+)
+
+// legacyEventMaxFieldNumbers holds, for the categories which gained events since version 1 of the protocol, the
+// highest field number of their event oneof known to version 1 clients.
+var legacyEventMaxFieldNumbers = map[EventCategory]protoreflect.FieldNumber{ //nolint:gochecknoglobals
+	EventCategory_APP_EVENTS:  11, // certificateInstallFailed
+	EventCategory_MAIL_EVENTS: 6,  // apiCertIssue
+	EventCategory_USER_EVENTS: 9,  // syncProgressEvent
+}
+
+// negotiateEventProtocol returns the protocol version used for a client requesting the given one. Clients which do
+// not request a version, or request one bridge does not know, get the legacy protocol.
+func negotiateEventProtocol(requested uint32) uint32 {
+	switch requested {
+	case eventProtocolV1, eventProtocolV2:
+		return requested
+
+	default:
+		return eventProtocolV1
+	}
+}
+
+// protocolVersion returns the first protocol version in which the event exists.
+func (x *StreamEvent) protocolVersion() uint32 {
+	maxField, ok := legacyEventMaxFieldNumbers[x.Category()]
+	if !ok {
+		return eventProtocolV1
+	}
+
+	if field := innermostEventField(x); field != nil && field.Number() > maxField {
+		return eventProtocolV2
+	}
+
+	return eventProtocolV1
+}
+
+// forProtocol returns the event as sent to clients using the given protocol version, or nil if they don't know it.
+func (x *StreamEvent) forProtocol(version uint32) *StreamEvent {
+	if version >= eventProtocolV2 {
+		return x
+	}
+
+	if x.protocolVersion() > version {
+		return nil
+	}
+
+	if x.Sequence == 0 && len(x.Metadata) == 0 {
+		return x
+	}
+
+	// The event is shared by all the streams, so the fields unknown to the client are removed from a copy.
+	legacy := proto.Clone(x).(*StreamEvent) //nolint:forcetypeassert
+	legacy.Sequence = 0
+	legacy.Metadata = nil
+
+	return legacy
+}
+
+// innermostEventField returns the field of the event oneof set in the category message of the event, if any.
+func innermostEventField(event *StreamEvent) protoreflect.FieldDescriptor {
+	msg := event.ProtoReflect()
+
+	field := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("event"))
+	if field == nil {
+		return nil
+	}
+
+	categoryMsg := msg.Get(field).Message()
+
+	oneof := categoryMsg.Descriptor().Oneofs().ByName("event")
+	if oneof == nil {
+		return nil
+	}
+
+	return categoryMsg.WhichOneof(oneof)
+}
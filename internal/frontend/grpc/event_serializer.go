@@ -0,0 +1,132 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// The serialization formats of stream events.
+const (
+	EventFormatProtobuf = "protobuf"
+	EventFormatJSON     = "json"
+	EventFormatMsgpack  = "msgpack"
+)
+
+// ErrUnknownEventFormat is returned when an event serializer is requested for an unknown format.
+var ErrUnknownEventFormat = errors.New("unknown event serialization format")
+
+// EventSerializer serializes stream events for the transports which do not use the gRPC encoding, such as WebSocket.
+type EventSerializer interface {
+	Marshal(event *StreamEvent) ([]byte, error)
+	Unmarshal(b []byte, event *StreamEvent) error
+
+	// IsBinary returns whether the serialized events are binary, rather than UTF-8 text.
+	IsBinary() bool
+}
+
+// NewEventSerializer returns the event serializer of the given format. An empty format selects JSON.
+func NewEventSerializer(format string) (EventSerializer, error) {
+	switch format {
+	case EventFormatProtobuf:
+		return protobufEventSerializer{}, nil
+
+	case EventFormatJSON, "":
+		return jsonEventSerializer{}, nil
+
+	case EventFormatMsgpack:
+		return msgpackEventSerializer{}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEventFormat, format)
+	}
+}
+
+// protobufEventSerializer serializes events with the protobuf wire format, as gRPC does.
+type protobufEventSerializer struct{}
+
+func (protobufEventSerializer) Marshal(event *StreamEvent) ([]byte, error) {
+	return proto.Marshal(event)
+}
+
+func (protobufEventSerializer) Unmarshal(b []byte, event *StreamEvent) error {
+	return proto.Unmarshal(b, event)
+}
+
+func (protobufEventSerializer) IsBinary() bool {
+	return true
+}
+
+// jsonEventSerializer serializes events with the canonical JSON mapping of protobuf messages.
+type jsonEventSerializer struct{}
+
+func (jsonEventSerializer) Marshal(event *StreamEvent) ([]byte, error) {
+	return protojson.Marshal(event)
+}
+
+func (jsonEventSerializer) Unmarshal(b []byte, event *StreamEvent) error {
+	return protojson.Unmarshal(b, event)
+}
+
+func (jsonEventSerializer) IsBinary() bool {
+	return false
+}
+
+// msgpackEventSerializer serializes events as msgpack maps with the same structure as their JSON serialization,
+// so that msgpack clients get the same field names as JSON ones.
+type msgpackEventSerializer struct{}
+
+func (msgpackEventSerializer) Marshal(event *StreamEvent) ([]byte, error) {
+	b, err := protojson.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	var value map[string]any
+
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+
+	return msgpack.Marshal(value)
+}
+
+func (msgpackEventSerializer) Unmarshal(b []byte, event *StreamEvent) error {
+	var value map[string]any
+
+	if err := msgpack.Unmarshal(b, &value); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return protojson.Unmarshal(b, event)
+}
+
+func (msgpackEventSerializer) IsBinary() bool {
+	return true
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEventSerializer_RoundTrip(t *testing.T) {
+	events := append(newTestEvents(),
+		NewGenericErrorEvent(ErrorCode_TLS_CERT_EXPORT_ERROR),
+		NewShowMainWindowEvent().WithMetadata(map[string]string{"requestID": "42"}),
+	)
+
+	// Events may carry a sequence, and 64-bit values which do not fit in a JSON number.
+	events[0].Sequence = 42
+	events = append(events, NewUsedBytesChangedEvent("userID", 1<<62))
+
+	for _, format := range []string{EventFormatProtobuf, EventFormatJSON, EventFormatMsgpack} {
+		t.Run(format, func(t *testing.T) {
+			serializer, err := NewEventSerializer(format)
+			require.NoError(t, err)
+
+			for _, event := range events {
+				b, err := serializer.Marshal(event)
+				require.NoError(t, err)
+
+				var received StreamEvent
+				require.NoError(t, serializer.Unmarshal(b, &received))
+				require.True(t, proto.Equal(event, &received), "expected %v, got %v", event, &received)
+			}
+		})
+	}
+}
+
+func TestEventSerializer_Formats(t *testing.T) {
+	serializer, err := NewEventSerializer("")
+	require.NoError(t, err)
+	require.Equal(t, jsonEventSerializer{}, serializer)
+	require.False(t, serializer.IsBinary())
+
+	for _, format := range []string{EventFormatProtobuf, EventFormatMsgpack} {
+		serializer, err := NewEventSerializer(format)
+		require.NoError(t, err)
+		require.True(t, serializer.IsBinary())
+	}
+
+	_, err = NewEventSerializer("xml")
+	require.ErrorIs(t, err, ErrUnknownEventFormat)
+}
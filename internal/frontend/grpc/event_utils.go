@@ -17,7 +17,11 @@
 
 package grpc
 
-import "github.com/bradenaw/juniper/xslices"
+import (
+	"strings"
+
+	"github.com/bradenaw/juniper/xslices"
+)
 
 // isInternetStatus returns true iff the event is InternetStatus.
 func (x *StreamEvent) isInternetStatus() bool {
@@ -30,3 +34,80 @@ func (x *StreamEvent) isInternetStatus() bool {
 func filterOutInternetStatusEvents(events []*StreamEvent) []*StreamEvent {
 	return xslices.Filter(events, func(event *StreamEvent) bool { return !event.isInternetStatus() })
 }
+
+// WithMetadata adds the given key/value pairs to the event metadata and returns the event, so that it can be chained
+// before a call to SendEvent. Metadata is informational only and is ignored when filtering queued events.
+func (x *StreamEvent) WithMetadata(metadata map[string]string) *StreamEvent {
+	if len(metadata) == 0 {
+		return x
+	}
+
+	if x.Metadata == nil {
+		x.Metadata = make(map[string]string, len(metadata))
+	}
+
+	for key, value := range metadata {
+		x.Metadata[key] = value
+	}
+
+	return x
+}
+
+// Category returns the category of the event, i.e. which event field of the StreamEvent is set.
+func (x *StreamEvent) Category() EventCategory {
+	switch x.GetEvent().(type) {
+	case *StreamEvent_Login:
+		return EventCategory_LOGIN_EVENTS
+
+	case *StreamEvent_Update:
+		return EventCategory_UPDATE_EVENTS
+
+	case *StreamEvent_Cache:
+		return EventCategory_CACHE_EVENTS
+
+	case *StreamEvent_MailServerSettings:
+		return EventCategory_MAIL_SERVER_SETTINGS_EVENTS
+
+	case *StreamEvent_Keychain:
+		return EventCategory_KEYCHAIN_EVENTS
+
+	case *StreamEvent_Mail:
+		return EventCategory_MAIL_EVENTS
+
+	case *StreamEvent_User:
+		return EventCategory_USER_EVENTS
+
+	case *StreamEvent_GenericError:
+		return EventCategory_GENERIC_ERROR_EVENTS
+
+	default:
+		return EventCategory_APP_EVENTS
+	}
+}
+
+// Kind returns the kind of the event, made of the names of the StreamEvent field which is set and of the field set in
+// the category event, e.g. "login.finished", or just the former for categories with a single kind of event, e.g.
+// "genericError". It is derived from the oneof fields, so that events can be routed without knowing about every event.
+// It returns an empty string if no event is set.
+func (x *StreamEvent) Kind() string {
+	fields, _ := eventPath(x)
+
+	names := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		names = append(names, string(field.Name()))
+	}
+
+	return strings.Join(names, ".")
+}
+
+// isStreamControl returns true iff the event is about the event stream itself rather than bridge, such as heartbeats.
+// These events are sent to all the streams, whatever the categories of events they are interested in.
+func (x *StreamEvent) isStreamControl() bool {
+	appEvent := x.GetApp()
+
+	return appEvent.GetHeartbeat() != nil ||
+		appEvent.GetStreamEnding() != nil ||
+		appEvent.GetEventsDropped() != nil ||
+		appEvent.GetResyncRequired() != nil
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStreamEvent_MetadataRoundTrip(t *testing.T) {
+	event := NewShowMainWindowEvent().WithMetadata(map[string]string{"requestID": "42"})
+	event.WithMetadata(map[string]string{"origin": "test"})
+
+	b, err := proto.Marshal(event)
+	require.NoError(t, err)
+
+	var received StreamEvent
+	require.NoError(t, proto.Unmarshal(b, &received))
+
+	require.NotNil(t, received.GetApp().GetShowMainWindow())
+	require.Equal(t, map[string]string{"requestID": "42", "origin": "test"}, received.GetMetadata())
+}
+
+func TestStreamEvent_MetadataEmpty(t *testing.T) {
+	event := NewShowMainWindowEvent().WithMetadata(nil)
+	require.Nil(t, event.GetMetadata())
+}
+
+func TestStreamEvent_MetadataIgnoredByQueueFiltering(t *testing.T) {
+	s := &Service{}
+
+	s.queueEvent(NewInternetStatusEvent(false).WithMetadata(map[string]string{"requestID": "1"}))
+	s.queueEvent(NewShowMainWindowEvent())
+	s.queueEvent(NewInternetStatusEvent(true).WithMetadata(map[string]string{"requestID": "2"}))
+
+	require.Len(t, s.eventQueue, 2)
+	require.NotNil(t, s.eventQueue[0].GetApp().GetShowMainWindow())
+	require.True(t, s.eventQueue[1].GetApp().GetInternetStatus().GetConnected())
+	require.Equal(t, "2", s.eventQueue[1].GetMetadata()["requestID"])
+}
+
+func TestStreamEvent_CategoryAndKind(t *testing.T) {
+	categoryFields := map[EventCategory]string{
+		EventCategory_APP_EVENTS:                  "app",
+		EventCategory_LOGIN_EVENTS:                "login",
+		EventCategory_UPDATE_EVENTS:               "update",
+		EventCategory_CACHE_EVENTS:                "cache",
+		EventCategory_MAIL_SERVER_SETTINGS_EVENTS: "mailServerSettings",
+		EventCategory_KEYCHAIN_EVENTS:             "keychain",
+		EventCategory_MAIL_EVENTS:                 "mail",
+		EventCategory_USER_EVENTS:                 "user",
+		EventCategory_GENERIC_ERROR_EVENTS:        "genericError",
+	}
+
+	categories := make(map[EventCategory]bool)
+
+	for _, event := range newTestEvents() {
+		field, ok := categoryFields[event.Category()]
+		require.True(t, ok, event.Category())
+
+		categoryField, eventField, _ := strings.Cut(event.Kind(), ".")
+		require.Equal(t, field, categoryField, event.Kind())
+		require.NotEmpty(t, eventField, event.Kind())
+
+		categories[event.Category()] = true
+	}
+
+	// Every category but generic errors has test events.
+	require.Len(t, categories, len(categoryFields)-1)
+
+	require.Equal(t, "login.finished", NewLoginFinishedEvent("userID", false).Kind())
+	require.Equal(t, "user.accountAutoDisabledEvent", NewAccountAutoDisabledEvent("userID", "reason").Kind())
+	require.Equal(t, "app.heartbeat", NewHeartbeatEvent().Kind())
+
+	genericError := NewGenericErrorEvent(ErrorCode_UNKNOWN_ERROR)
+	require.Equal(t, EventCategory_GENERIC_ERROR_EVENTS, genericError.Category())
+	require.Equal(t, "genericError", genericError.Kind())
+
+	require.Empty(t, (&StreamEvent{}).Kind())
+}
+
+func TestNewShutdownPendingSendsEvent(t *testing.T) {
+	require.Equal(t, int32(2), NewShutdownPendingSendsEvent(2).GetApp().GetShutdownPendingSends().GetAbandoned())
+}
+
+func TestNewServerRateLimitedEvent(t *testing.T) {
+	event := NewServerRateLimitedEvent(1500*time.Millisecond, "/core/v4/events").GetApp().GetServerRateLimited()
+
+	require.Equal(t, int64(1500), event.GetRetryAfterMs())
+	require.Equal(t, "/core/v4/events", event.GetEndpoint())
+}
+
+func TestNewKeyGenerationRateLimitedEvent(t *testing.T) {
+	event := NewKeyGenerationRateLimitedEvent(30 * time.Second).GetApp().GetKeyGenerationRateLimited()
+
+	require.Equal(t, int64(30000), event.GetRetryAfterMs())
+}
+
+func TestNewUploadProgressEvent(t *testing.T) {
+	event := NewUploadProgressEvent("userID", 5, 10).GetUser().GetUploadProgressEvent()
+
+	require.Equal(t, "userID", event.GetUserID())
+	require.Equal(t, int32(5), event.GetDone())
+	require.Equal(t, int32(10), event.GetTotal())
+}
+
+func TestNewAccountAutoDisabledEvent(t *testing.T) {
+	event := NewAccountAutoDisabledEvent("userID", "reason").GetUser().GetAccountAutoDisabledEvent()
+
+	require.Equal(t, "userID", event.GetUserID())
+	require.Equal(t, "reason", event.GetReason())
+}
+
+func TestNewMailScheduledSendDeliveredEvent(t *testing.T) {
+	event := NewMailScheduledSendDeliveredEvent("userID", "messageID").GetMail().GetScheduledSendDelivered()
+
+	require.Equal(t, "userID", event.GetUserID())
+	require.Equal(t, "messageID", event.GetMessageID())
+}
+
+func TestNewMailSentFromAddressEvent(t *testing.T) {
+	event := NewMailSentFromAddressEvent("userID", "messageID", "from@pm.me").GetMail().GetSentFromAddress()
+
+	require.Equal(t, "userID", event.GetUserID())
+	require.Equal(t, "messageID", event.GetMessageID())
+	require.Equal(t, "from@pm.me", event.GetFromAddress())
+}
+
+func TestNewMailMessageTruncatedEvent(t *testing.T) {
+	event := NewMailMessageTruncatedEvent("userID", "messageID", "reason", 3).GetMail().GetMessageTruncated()
+
+	require.Equal(t, "userID", event.GetUserID())
+	require.Equal(t, "messageID", event.GetMessageID())
+	require.Equal(t, "reason", event.GetReason())
+	require.Equal(t, int32(3), event.GetCount())
+}
+
+func TestNewMailSendDedupedEvent(t *testing.T) {
+	event := NewMailSendDedupedEvent("userID", "messageID", "subject", "to@pm.me").GetMail().GetSendDeduped()
+
+	require.Equal(t, "userID", event.GetUserID())
+	require.Equal(t, "messageID", event.GetMessageID())
+	require.Equal(t, "subject", event.GetSubject())
+	require.Equal(t, "to@pm.me", event.GetRecipient())
+}
+
+func TestNewCorruptCacheEntryEvent(t *testing.T) {
+	event := NewCorruptCacheEntryEvent("userID", "messageID", true).GetUser().GetCorruptCacheEntryEvent()
+
+	require.Equal(t, "userID", event.GetUserID())
+	require.Equal(t, "messageID", event.GetMessageID())
+	require.True(t, event.GetRecovered())
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/0xAX/notificator"
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Notifier pushes notifications to the OS notification center.
+type Notifier interface {
+	Notify(title, text string) error
+}
+
+// osNotifier is the default notifier, relying on the same notification center integration as the crash handler.
+type osNotifier struct {
+	notify *notificator.Notificator
+}
+
+func newOSNotifier() *osNotifier {
+	return &osNotifier{notify: notificator.New(notificator.Options{AppName: constants.FullAppName})}
+}
+
+func (n *osNotifier) Notify(title, text string) error {
+	return n.notify.Push(title, text, "", notificator.UR_NORMAL)
+}
+
+// notificationTexts returns the text of the notification for each event type which can be forwarded.
+var notificationTexts = map[string]func(*StreamEvent) string{ //nolint:gochecknoglobals
+	"InternetStatusEvent": func(event *StreamEvent) string {
+		if event.GetApp().GetInternetStatus().GetConnected() {
+			return "The internet connection has been restored."
+		}
+
+		return "The internet connection has been lost."
+	},
+	"UpdateManualReadyEvent": func(event *StreamEvent) string {
+		return fmt.Sprintf("Version %v is available.", event.GetUpdate().GetManualReady().GetVersion())
+	},
+	"UpdateManualRestartNeededEvent": func(*StreamEvent) string {
+		return "Restart to finish installing the update."
+	},
+	"UpdateForceEvent": func(event *StreamEvent) string {
+		return fmt.Sprintf("Version %v must be installed to continue.", event.GetUpdate().GetForce().GetVersion())
+	},
+	"AddressChangedLogoutEvent": func(event *StreamEvent) string {
+		return fmt.Sprintf("The address %v has changed, please log in again.", event.GetMail().GetAddressChangedLogout().GetAddress())
+	},
+	"NoActiveKeyForRecipientEvent": func(event *StreamEvent) string {
+		return fmt.Sprintf("No active key was found for the recipient %v.", event.GetMail().GetNoActiveKeyForRecipientEvent().GetEmail())
+	},
+	"ApiCertIssueEvent": func(*StreamEvent) string {
+		return "A secure connection to the Proton servers could not be established."
+	},
+}
+
+// defaultNotifiedEventTypes are the event types forwarded to the OS notification center by default.
+var defaultNotifiedEventTypes = []string{ //nolint:gochecknoglobals
+	"UpdateManualReadyEvent",
+	"UpdateForceEvent",
+	"AddressChangedLogoutEvent",
+	"ApiCertIssueEvent",
+}
+
+// eventType returns the name of the innermost event message of the given stream event, e.g. "UpdateForceEvent".
+func eventType(event *StreamEvent) string {
+	msg := innermostEvent(event)
+	if msg == nil {
+		return ""
+	}
+
+	return string(msg.Descriptor().Name())
+}
+
+// innermostEvent returns the innermost event message of the given stream event, or nil if no event is set.
+func innermostEvent(event *StreamEvent) protoreflect.Message {
+	_, msg := eventPath(event)
+
+	return msg
+}
+
+// eventPath walks the "event" oneof fields from the given stream event down to its innermost event message. It returns
+// the fields set along the way, and the innermost message, which is nil if no event is set at some level.
+func eventPath(event *StreamEvent) ([]protoreflect.FieldDescriptor, protoreflect.Message) {
+	var fields []protoreflect.FieldDescriptor
+
+	msg := protoreflect.Message(event.ProtoReflect())
+
+	for {
+		oneof := msg.Descriptor().Oneofs().ByName("event")
+		if oneof == nil {
+			return fields, msg
+		}
+
+		field := msg.WhichOneof(oneof)
+		if field == nil || field.Message() == nil {
+			return fields, nil
+		}
+
+		fields = append(fields, field)
+		msg = msg.Get(field).Message()
+	}
+}
+
+// notificationForwarder forwards the configured stream events to the OS notification center while the GUI is not
+// focused. The GUI is assumed to be focused until it reports otherwise, so that GUIs which handle notifications
+// themselves do not get duplicates.
+type notificationForwarder struct {
+	notifier     Notifier
+	panicHandler async.PanicHandler
+
+	lock       sync.RWMutex
+	eventTypes map[string]struct{}
+	unfocused  bool
+
+	// pushing tracks the notifications being pushed.
+	pushing sync.WaitGroup
+}
+
+func newNotificationForwarder(notifier Notifier, panicHandler async.PanicHandler) *notificationForwarder {
+	forwarder := &notificationForwarder{notifier: notifier, panicHandler: panicHandler}
+
+	forwarder.setEventTypes(defaultNotifiedEventTypes)
+
+	return forwarder
+}
+
+// setEventTypes sets the event types which are forwarded. Types for which no notification text exists are ignored.
+func (f *notificationForwarder) setEventTypes(eventTypes []string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.eventTypes = make(map[string]struct{}, len(eventTypes))
+
+	for _, eventType := range eventTypes {
+		if _, ok := notificationTexts[eventType]; !ok {
+			logrus.WithField("eventType", eventType).Warn("Event type cannot be forwarded as a notification")
+			continue
+		}
+
+		f.eventTypes[eventType] = struct{}{}
+	}
+}
+
+// getEventTypes returns the event types which are forwarded, sorted by name.
+func (f *notificationForwarder) getEventTypes() []string {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	eventTypes := maps.Keys(f.eventTypes)
+
+	slices.Sort(eventTypes)
+
+	return eventTypes
+}
+
+func (f *notificationForwarder) setGUIFocused(focused bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.unfocused = !focused
+}
+
+// forward pushes a notification for the given event if its type is forwarded and the GUI is not focused.
+// The notification is pushed in a goroutine, as the notifier may run an external command.
+func (f *notificationForwarder) forward(event *StreamEvent) {
+	if f == nil {
+		return
+	}
+
+	eventType := eventType(event)
+
+	f.lock.RLock()
+	_, forwarded := f.eventTypes[eventType]
+	unfocused := f.unfocused
+	f.lock.RUnlock()
+
+	if !forwarded || !unfocused {
+		return
+	}
+
+	text := notificationTexts[eventType](event)
+
+	f.pushing.Add(1)
+
+	go func() {
+		defer async.HandlePanic(f.panicHandler)
+		defer f.pushing.Done()
+
+		if err := f.notifier.Notify(constants.FullAppName, text); err != nil {
+			logrus.WithError(err).WithField("eventType", eventType).Warn("Failed to push notification")
+		}
+	}()
+}
+
+// wait waits for the notifications being pushed.
+func (f *notificationForwarder) wait() {
+	f.pushing.Wait()
+}
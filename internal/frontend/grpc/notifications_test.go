@@ -0,0 +1,154 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockNotifier struct {
+	lock  sync.Mutex
+	texts []string
+	err   error
+}
+
+func (n *mockNotifier) Notify(_, text string) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.texts = append(n.texts, text)
+
+	return n.err
+}
+
+func (n *mockNotifier) notified() []string {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return append([]string{}, n.texts...)
+}
+
+func TestEventType(t *testing.T) {
+	require.Equal(t, "UpdateForceEvent", eventType(NewUpdateForceEvent("3.0.0")))
+	require.Equal(t, "InternetStatusEvent", eventType(NewInternetStatusEvent(true)))
+	require.Equal(t, "ApiCertIssueEvent", eventType(NewMailApiCertIssue()))
+	require.Equal(t, "", eventType(&StreamEvent{}))
+}
+
+func TestNotificationForwarder_ConfiguredTypes(t *testing.T) {
+	notifier := &mockNotifier{}
+	forwarder := newNotificationForwarder(notifier, nil)
+	forwarder.setGUIFocused(false)
+
+	forwarder.setEventTypes([]string{"UpdateForceEvent", "InternetStatusEvent"})
+
+	forwarder.forward(NewUpdateForceEvent("3.0.0"))
+	forwarder.forward(NewInternetStatusEvent(false))
+	forwarder.forward(NewMailApiCertIssue())
+	forwarder.forward(NewUpdateManualReadyEvent("3.0.0"))
+
+	forwarder.wait()
+	require.ElementsMatch(t, []string{
+		"Version 3.0.0 must be installed to continue.",
+		"The internet connection has been lost.",
+	}, notifier.notified())
+}
+
+func TestNotificationForwarder_DefaultTypes(t *testing.T) {
+	notifier := &mockNotifier{}
+	forwarder := newNotificationForwarder(notifier, nil)
+	forwarder.setGUIFocused(false)
+
+	forwarder.forward(NewInternetStatusEvent(false))
+	forwarder.forward(NewMailApiCertIssue())
+
+	forwarder.wait()
+	require.Equal(t, []string{"A secure connection to the Proton servers could not be established."}, notifier.notified())
+}
+
+func TestNotificationForwarder_UnknownTypeIgnored(t *testing.T) {
+	notifier := &mockNotifier{}
+	forwarder := newNotificationForwarder(notifier, nil)
+	forwarder.setGUIFocused(false)
+
+	forwarder.setEventTypes([]string{"ToggleSplitModeFinishedEvent"})
+
+	forwarder.forward(NewUserToggleSplitModeFinishedEvent("userID"))
+
+	forwarder.wait()
+	require.Empty(t, notifier.notified())
+}
+
+func TestNotificationForwarder_EventTypes(t *testing.T) {
+	forwarder := newNotificationForwarder(&mockNotifier{}, nil)
+
+	require.Equal(t, []string{"AddressChangedLogoutEvent", "ApiCertIssueEvent", "UpdateForceEvent", "UpdateManualReadyEvent"}, forwarder.getEventTypes())
+
+	forwarder.setEventTypes(nil)
+
+	require.Empty(t, forwarder.getEventTypes())
+}
+
+func TestNotificationForwarder_Focused(t *testing.T) {
+	notifier := &mockNotifier{}
+	forwarder := newNotificationForwarder(notifier, nil)
+
+	// The GUI is considered focused until it says otherwise.
+	forwarder.forward(NewMailApiCertIssue())
+	forwarder.wait()
+	require.Empty(t, notifier.notified())
+
+	forwarder.setGUIFocused(false)
+	forwarder.forward(NewMailApiCertIssue())
+	forwarder.wait()
+	require.Len(t, notifier.notified(), 1)
+
+	forwarder.setGUIFocused(true)
+	forwarder.forward(NewMailApiCertIssue())
+	forwarder.wait()
+	require.Len(t, notifier.notified(), 1)
+}
+
+func TestNotificationForwarder_NotifierError(t *testing.T) {
+	notifier := &mockNotifier{err: errors.New("no notification center")}
+	forwarder := newNotificationForwarder(notifier, nil)
+	forwarder.setGUIFocused(false)
+
+	require.NotPanics(t, func() { forwarder.forward(NewMailApiCertIssue()) })
+	forwarder.wait()
+	require.Len(t, notifier.notified(), 1)
+}
+
+func TestService_SendEventForwardsNotifications(t *testing.T) {
+	notifier := &mockNotifier{}
+
+	s := newTestService()
+	s.notifications = newNotificationForwarder(notifier, nil)
+	s.notifications.setEventTypes([]string{"UpdateForceEvent"})
+	s.notifications.setGUIFocused(false)
+
+	_ = s.SendEvent(NewUpdateForceEvent("3.0.0"))
+	_ = s.SendEvent(NewMailApiCertIssue())
+
+	s.notifications.wait()
+	require.Equal(t, []string{"Version 3.0.0 must be installed to continue."}, notifier.notified())
+}
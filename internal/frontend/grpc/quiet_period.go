@@ -0,0 +1,136 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bradenaw/juniper/xslices"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// coalescedEventTypes are the high-frequency event types of which only the latest one is kept during the quiet period.
+var coalescedEventTypes = map[string]struct{}{ //nolint:gochecknoglobals
+	"InternetStatusEvent":   {},
+	"UserChangedEvent":      {},
+	"UsedBytesChangedEvent": {},
+	"SyncProgressEvent":     {},
+	"UploadProgressEvent":   {},
+	"AddressChangedEvent":   {},
+}
+
+// criticalEventTypes are the event types, besides errors, which are never held back by the quiet period.
+var criticalEventTypes = map[string]struct{}{ //nolint:gochecknoglobals
+	"UpdateForceEvent":               {},
+	"ApiCertIssueEvent":              {},
+	"UserBadEvent":                   {},
+	"CertificateInstallFailedEvent":  {},
+	"NoActiveKeyForRecipientEvent":   {},
+	"KeyGenerationRateLimitedEvent":  {},
+	"UpdateManualRestartNeededEvent": {},
+}
+
+func isCriticalEvent(eventType string) bool {
+	if strings.HasSuffix(eventType, "ErrorEvent") {
+		return true
+	}
+
+	_, ok := criticalEventTypes[eventType]
+
+	return ok
+}
+
+// coalesceKey returns the key under which the given event is coalesced, i.e. its type and, for user or address events,
+// the user ID or address, and false if the event is not coalesced.
+func coalesceKey(event *StreamEvent) (string, bool) {
+	eventType := eventType(event)
+	if _, ok := coalescedEventTypes[eventType]; !ok {
+		return "", false
+	}
+
+	msg := innermostEvent(event)
+
+	for _, name := range []protoreflect.Name{"userID", "address"} {
+		if field := msg.Descriptor().Fields().ByName(name); field != nil {
+			return eventType + "/" + msg.Get(field).String(), true
+		}
+	}
+
+	return eventType, true
+}
+
+// quietPeriod holds back the events sent right after an event stream started, so that a freshly connected client is
+// not flooded. Critical events are not held back, and only the latest of the high-frequency events is kept.
+// Held events are released in order once the period is over.
+type quietPeriod struct {
+	timer *time.Timer
+	held  []heldEvent
+}
+
+type heldEvent struct {
+	event *StreamEvent
+	key   string // The coalesce key, empty if the event is not coalesced.
+}
+
+// newQuietPeriod starts a quiet period of the given duration. A non-positive duration disables it.
+func newQuietPeriod(duration time.Duration) *quietPeriod {
+	if duration <= 0 {
+		return &quietPeriod{}
+	}
+
+	return &quietPeriod{timer: time.NewTimer(duration)}
+}
+
+// done returns a channel which receives once the quiet period is over; it is nil if the period is already over.
+func (q *quietPeriod) done() <-chan time.Time {
+	if q.timer == nil {
+		return nil
+	}
+
+	return q.timer.C
+}
+
+// hold holds back the given event, and returns false if the event should be sent right away instead.
+func (q *quietPeriod) hold(event *StreamEvent) bool {
+	if q.timer == nil || isCriticalEvent(eventType(event)) {
+		return false
+	}
+
+	key, ok := coalesceKey(event)
+	if ok {
+		q.held = xslices.Filter(q.held, func(held heldEvent) bool { return held.key != key })
+	}
+
+	q.held = append(q.held, heldEvent{event: event, key: key})
+
+	return true
+}
+
+// release ends the quiet period and returns the events held back, oldest first.
+func (q *quietPeriod) release() []*StreamEvent {
+	if q.timer != nil {
+		q.timer.Stop()
+		q.timer = nil
+	}
+
+	held := xslices.Map(q.held, func(held heldEvent) *StreamEvent { return held.event })
+	q.held = nil
+
+	return held
+}
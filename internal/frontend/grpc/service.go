@@ -27,10 +27,12 @@ import (
 	"io/fs"
 	"math/rand"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ProtonMail/gluon/async"
@@ -56,20 +58,40 @@ import (
 const (
 	serverConfigFileName        = "grpcServerConfig.json"
 	serverTokenMetadataKey      = "server-token"
-	twoPasswordsMaxAttemptCount = 3 // The number of attempts allowed for the mailbox password.
+	twoPasswordsMaxAttemptCount = 3    // The number of attempts allowed for the mailbox password.
+	eventQueueMaxSize           = 1000 // The number of events kept while no client is streaming events.
+	eventHistoryMaxSize         = 1000 // The number of past events kept to be replayed to reconnecting clients.
 )
 
 // Service is the RPC service struct.
 type Service struct { // nolint:structcheck
 	UnimplementedBridgeServer
 
-	grpcServer         *grpc.Server //  the gGRPC server
-	listener           net.Listener
-	eventStreamCh      chan *StreamEvent
-	eventStreamChMutex sync.RWMutex
-	eventStreamDoneCh  chan struct{}
-	eventQueue         []*StreamEvent
-	eventQueueMutex    sync.Mutex
+	grpcServer        *grpc.Server //  the gGRPC server
+	listener          net.Listener
+	eventQueue        []*StreamEvent
+	eventQueueMaxSize int // A non-positive size means the queue is unbounded.
+	eventQueueDropped int
+	eventQueueMutex   sync.Mutex
+	eventHistory      []*StreamEvent // The last events sent, by increasing sequence. Guarded by eventQueueMutex.
+	eventHistoryMax   int            // A non-positive size disables the history.
+	eventSequence     uint64         // The sequence of the last event sent. Guarded by eventQueueMutex.
+	activeStreams     map[string]*activeStream
+	activeStreamsLock sync.Mutex
+	notifications     *notificationForwarder
+	webSocketListener net.Listener // Only set if the WebSocket event endpoint is enabled.
+	webSocketServer   *http.Server
+
+	eventStreamQuietPeriod  atomic.Int64 // The quiet period of new event streams, in nanoseconds.
+	eventStreamHeartbeat    atomic.Int64 // The heartbeat interval of new event streams, in nanoseconds; 0 disables it.
+	eventStreamBufferSize   atomic.Int64 // The number of events buffered for new event streams; 0 selects the default.
+	eventSendPolicy         atomic.Int32 // The EventSendPolicy applied when a stream buffer is full.
+	eventSendTimeout        atomic.Int64 // How long to wait for room in a stream buffer, in nanoseconds; 0 selects the default.
+	eventAckTimeout         atomic.Int64 // How long clients have to acknowledge critical events, in nanoseconds; 0 selects the default.
+	eventStreamDrainTimeout atomic.Int64 // How long stopped streams forward their buffered events, in nanoseconds; 0 selects the default.
+	eventAcks               eventAcks
+	eventDebounceInterval   atomic.Int64 // How long coalesced events are held back to keep only the latest, in nanoseconds; 0 disables it.
+	eventDebouncer          eventDebouncer
 
 	panicHandler async.PanicHandler
 	restarter    Restarter
@@ -144,6 +166,13 @@ func NewService(
 		config.Port = address.Port
 	}
 
+	var webSocketListener net.Listener
+	if bridge.GetWebSocketEvents() {
+		if webSocketListener, config.WebSocketPort, err = newWebSocketListener(); err != nil {
+			logrus.WithError(err).Panic("Could not create WebSocket event listener")
+		}
+	}
+
 	if path, err := service.SaveGRPCServerConfigFile(locations, &config, serverConfigFileName); err != nil {
 		logrus.WithError(err).WithField("path", path).Panic("Could not write gRPC service config file")
 	} else {
@@ -156,7 +185,11 @@ func NewService(
 			grpc.UnaryInterceptor(newUnaryTokenValidator(config.Token)),
 			grpc.StreamInterceptor(newStreamTokenValidator(config.Token)),
 		),
-		listener: listener,
+		listener:          listener,
+		notifications:     newNotificationForwarder(newOSNotifier(), panicHandler),
+		webSocketListener: webSocketListener,
+		eventQueueMaxSize: eventQueueMaxSize,
+		eventHistoryMax:   eventHistoryMaxSize,
 
 		panicHandler: panicHandler,
 		restarter:    restarter,
@@ -180,6 +213,16 @@ func NewService(
 		showOnStartup:   showOnStartup,
 	}
 
+	if webSocketListener != nil {
+		s.webSocketServer = s.newWebSocketServer(config.Token)
+	}
+
+	s.SetEventStreamHeartbeatInterval(defaultEventStreamHeartbeatInterval)
+
+	if eventTypes := bridge.GetNotifiedEventTypes(); eventTypes != nil {
+		s.notifications.setEventTypes(eventTypes)
+	}
+
 	// Initializing.Done is only called sync.Once. Please keep the increment set to 1
 	s.initializing.Add(1)
 
@@ -221,6 +264,8 @@ func (s *Service) Loop() error {
 		s.watchEvents()
 	}()
 
+	s.serveWebSocketEvents()
+
 	s.log.WithField("useFileSocket", useFileSocket()).Info("Starting gRPC server")
 
 	doneCh := make(chan struct{})
@@ -234,6 +279,7 @@ func (s *Service) Loop() error {
 			s.log.Info("Stopping gRPC server")
 			defer s.log.Info("Stopped gRPC server")
 
+			s.stopWebSocketEvents()
 			s.grpcServer.Stop()
 
 		case <-doneCh:
@@ -283,6 +329,27 @@ func (s *Service) watchEvents() {
 		case events.Raise:
 			_ = s.SendEvent(NewShowMainWindowEvent())
 
+		case events.ConfigMigrated:
+			_ = s.SendEvent(NewConfigMigratedEvent(event.FromVersion, event.ToVersion, event.Success))
+
+		case events.ShutdownPendingSends:
+			_ = s.SendEvent(NewShutdownPendingSendsEvent(event.Abandoned))
+
+		case events.ServerRateLimited:
+			_ = s.SendEvent(NewServerRateLimitedEvent(event.RetryAfter, event.Endpoint))
+
+		case events.KeyGenerationRateLimited:
+			_ = s.SendEvent(NewKeyGenerationRateLimitedEvent(event.RetryAfter))
+
+		case events.LoggingInfo:
+			_ = s.SendEvent(NewLoggingInfoEvent(event.Level, event.FilePath))
+
+		case events.CertRegenerated:
+			_ = s.SendEvent(NewCertRegeneratedEvent(event.Success, event.Fingerprint))
+
+		case events.AnotherInstanceRunning:
+			_ = s.SendEvent(NewAnotherInstanceRunningEvent(event.PID))
+
 		case events.UserAddressCreated:
 			_ = s.SendEvent(NewMailAddressChangeEvent(event.Email))
 
@@ -313,6 +380,9 @@ func (s *Service) watchEvents() {
 		case events.UserDeleted:
 			_ = s.SendEvent(NewUserChangedEvent(event.UserID))
 
+		case events.AccountAutoDisabled:
+			_ = s.SendEvent(NewAccountAutoDisabledEvent(event.UserID, event.Reason))
+
 		case events.AddressModeChanged:
 			_ = s.SendEvent(NewUserChangedEvent(event.UserID))
 
@@ -346,7 +416,20 @@ func (s *Service) watchEvents() {
 			}
 
 		case events.SyncProgress:
-			_ = s.SendEvent(NewSyncProgressEvent(event.UserID, event.Progress, event.Elapsed.Milliseconds(), event.Remaining.Milliseconds()))
+			_ = s.SendEvent(NewSyncProgressEvent(
+				event.UserID,
+				event.Progress,
+				event.Elapsed.Milliseconds(),
+				event.Remaining.Milliseconds(),
+				event.Processed,
+				event.Total,
+			))
+
+		case events.UploadProgress:
+			_ = s.SendEvent(NewUploadProgressEvent(event.UserID, event.Done, event.Total))
+
+		case events.CorruptCacheEntry:
+			_ = s.SendEvent(NewCorruptCacheEntryEvent(event.UserID, event.MessageID, event.Recovered))
 
 		case events.UpdateLatest:
 			safe.RLock(func() {
@@ -397,6 +480,30 @@ func (s *Service) watchEvents() {
 
 		case events.TLSIssue:
 			_ = s.SendEvent(NewMailApiCertIssue())
+
+		case events.SendCompleted:
+			_ = s.SendEvent(NewMailSendCompletedEvent(
+				event.UserID,
+				event.MessageID,
+				event.Duration.Milliseconds(),
+				int64(event.Size),
+				event.HashDuration.Milliseconds(),
+			))
+
+		case events.SendPriorInProgress:
+			_ = s.SendEvent(NewMailSendPriorInProgressEvent(event.UserID))
+
+		case events.ScheduledSendDelivered:
+			_ = s.SendEvent(NewMailScheduledSendDeliveredEvent(event.UserID, event.MessageID))
+
+		case events.SentFromAddress:
+			_ = s.SendEvent(NewMailSentFromAddressEvent(event.UserID, event.MessageID, event.FromAddress))
+
+		case events.MessageTruncated:
+			_ = s.SendEvent(NewMailMessageTruncatedEvent(event.UserID, event.MessageID, event.Reason, event.Count))
+
+		case events.SendDeduped:
+			_ = s.SendEvent(NewMailSendDedupedEvent(event.UserID, event.MessageID, event.Subject, event.Recipient))
 		}
 	}
 }
@@ -77,3 +77,16 @@ func (s *Service) ExportTLSCertificates(_ context.Context, folderPath *wrappersp
 
 	return &emptypb.Empty{}, nil
 }
+
+func (s *Service) RegenerateTLSCertificate(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	s.log.Info("RegenerateTLSCertificate")
+
+	go func() {
+		defer async.HandlePanic(s.panicHandler)
+
+		// The outcome is reported to the client through the CertRegenerated event.
+		_ = s.bridge.RegenerateBridgeTLSCert()
+	}()
+
+	return &emptypb.Empty{}, nil
+}
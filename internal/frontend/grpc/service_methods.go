@@ -102,6 +102,36 @@ func (s *Service) GuiReady(_ context.Context, _ *emptypb.Empty) (*GuiReadyRespon
 	}, nil
 }
 
+// SetIsGuiFocused implement the SetIsGuiFocused gRPC service call.
+func (s *Service) SetIsGuiFocused(_ context.Context, isFocused *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	s.log.WithField("isFocused", isFocused.Value).Debug("SetIsGuiFocused")
+
+	s.notifications.setGUIFocused(isFocused.Value)
+
+	return &emptypb.Empty{}, nil
+}
+
+// SetNotifiedEventTypes implement the SetNotifiedEventTypes gRPC service call.
+func (s *Service) SetNotifiedEventTypes(_ context.Context, eventTypes *NotifiedEventTypeList) (*emptypb.Empty, error) {
+	s.log.WithField("eventTypes", eventTypes.EventTypes).Debug("SetNotifiedEventTypes")
+
+	if err := s.bridge.SetNotifiedEventTypes(eventTypes.EventTypes); err != nil {
+		s.log.WithError(err).Error("Failed to set notified event types")
+		return nil, status.Errorf(codes.Internal, "failed to set notified event types: %v", err)
+	}
+
+	s.notifications.setEventTypes(eventTypes.EventTypes)
+
+	return &emptypb.Empty{}, nil
+}
+
+// NotifiedEventTypes implement the NotifiedEventTypes gRPC service call.
+func (s *Service) NotifiedEventTypes(_ context.Context, _ *emptypb.Empty) (*NotifiedEventTypeList, error) {
+	s.log.Debug("NotifiedEventTypes")
+
+	return &NotifiedEventTypeList{EventTypes: s.notifications.getEventTypes()}, nil
+}
+
 // Quit implement the Quit gRPC service call.
 func (s *Service) Quit(_ context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
 	s.log.Debug("Quit")
@@ -119,11 +149,13 @@ func (s *Service) quit() error {
 
 		var err error
 		if s.isStreamingEvents() {
-			if err = s.stopEventStream(); err != nil {
+			if err = s.stopEventStream(streamEndingReasonShutdown); err != nil {
 				s.log.WithError(err).Error("Quit failed.")
 			}
 		}
 
+		s.stopWebSocketEvents()
+
 		// The following call is launched as a goroutine, as it will wait for current calls to end, including this one.
 		s.grpcServer.GracefulStop() // gRPC does clean up and remove the file socket if used.
 	}()
@@ -227,6 +259,44 @@ func (s *Service) IsTelemetryDisabled(_ context.Context, _ *emptypb.Empty) (*wra
 	return wrapperspb.Bool(s.bridge.GetTelemetryDisabled()), nil
 }
 
+// SetIsWebSocketEventsEnabled sets whether events are also served over a local WebSocket endpoint.
+// The change takes effect the next time bridge starts.
+func (s *Service) SetIsWebSocketEventsEnabled(_ context.Context, isEnabled *wrapperspb.BoolValue) (*emptypb.Empty, error) {
+	s.log.WithField("isEnabled", isEnabled.Value).Debug("SetIsWebSocketEventsEnabled")
+
+	if err := s.bridge.SetWebSocketEvents(isEnabled.Value); err != nil {
+		s.log.WithError(err).Error("Failed to set WebSocket events")
+		return nil, status.Errorf(codes.Internal, "failed to set WebSocket events: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) IsWebSocketEventsEnabled(_ context.Context, _ *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	s.log.Debug("IsWebSocketEventsEnabled")
+
+	return wrapperspb.Bool(s.bridge.GetWebSocketEvents()), nil
+}
+
+// SetLogLevel changes the logging level at runtime, e.g. "debug" or "trace". The new level and log file are reported
+// with a LoggingInfoEvent.
+func (s *Service) SetLogLevel(_ context.Context, level *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	s.log.WithField("level", level.Value).Debug("SetLogLevel")
+
+	if err := s.bridge.SetLogLevel(level.Value); err != nil {
+		s.log.WithError(err).Error("Failed to set log level")
+		return nil, status.Errorf(codes.InvalidArgument, "failed to set log level: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) CurrentLogLevel(_ context.Context, _ *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	s.log.Debug("CurrentLogLevel")
+
+	return wrapperspb.String(s.bridge.GetLogLevel()), nil
+}
+
 func (s *Service) GoOs(_ context.Context, _ *emptypb.Empty) (*wrapperspb.StringValue, error) {
 	s.log.Debug("GoOs") // TO-DO We can probably get rid of this and use QSysInfo::product name
 
@@ -322,6 +392,13 @@ func (s *Service) CurrentEmailClient(_ context.Context, _ *emptypb.Empty) (*wrap
 	return wrapperspb.String(s.bridge.GetCurrentUserAgent()), nil
 }
 
+// GetSendRecorderState returns the entries of the users' send recorders, to be included in diagnostic reports.
+func (s *Service) GetSendRecorderState(_ context.Context, _ *emptypb.Empty) (*SendRecorderStateResponse, error) {
+	s.log.Debug("GetSendRecorderState")
+
+	return &SendRecorderStateResponse{Entries: grpcSendRecorderEntries(s.bridge.GetSendRecorderState())}, nil
+}
+
 func (s *Service) ReportBug(_ context.Context, report *ReportBugRequest) (*emptypb.Empty, error) {
 	s.log.WithFields(logrus.Fields{
 		"osType":      report.OsType,
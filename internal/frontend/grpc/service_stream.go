@@ -19,101 +19,424 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"time"
 
 	"github.com/ProtonMail/gluon/async"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+const (
+	streamEndingReasonStopped  = "stopped"  // The client requested the stream to stop.
+	streamEndingReasonShutdown = "shutdown" // The bridge is shutting down.
+)
+
+const (
+	defaultEventStreamBufferSize  = 100             // The number of events buffered for each stream by default.
+	defaultEventStreamSendTimeout = 5 * time.Second // How long SendEvent waits by default for room in a full stream buffer.
+
+	defaultEventStreamDrainTimeout = time.Second // How long a stopped stream forwards its buffered events by default.
+
+	defaultEventStreamHeartbeatInterval = 30 * time.Second // How long a stream may be idle by default before a heartbeat.
+)
+
+// errStreamClosedByClient is returned by streamEvents when the client closed the stream.
+var errStreamClosedByClient = errors.New("the client closed the event stream")
+
+// errStreamExited is returned by activeStream.send when the stream exited before taking the event.
+var errStreamExited = errors.New("the event stream exited")
+
+// ErrEventStreamFull is returned by SendEvent when the event could not be buffered in time for a stream whose client
+// is not reading events.
+var ErrEventStreamFull = errors.New("the event stream buffer is full")
+
+// EventSendPolicy defines what SendEvent does when the buffer of an event stream is full, i.e. when its client does not
+// read events fast enough.
+type EventSendPolicy int32
+
+const (
+	// EventSendPolicyBlockWithTimeout waits for room in the buffer, failing with ErrEventStreamFull after the timeout.
+	EventSendPolicyBlockWithTimeout EventSendPolicy = iota
+
+	// EventSendPolicyDropOldest drops the oldest buffered events to make room, so that SendEvent never waits.
+	EventSendPolicyDropOldest
+)
+
 // RunEventStream implement the gRPC server->Client event stream.
+// Several clients, e.g. the GUI and a CLI helper, may stream events at the same time; each receives all the events.
+// A client reconnecting after a previous stream can give the sequence of the last event it received, so that the events
+// it missed in the meantime are replayed first.
 func (s *Service) RunEventStream(request *EventStreamRequest, server Bridge_RunEventStreamServer) error {
-	s.log.Debug("Starting Event stream")
+	s.log.WithFields(logrus.Fields{
+		"lastSeenSequence": request.LastSeenSequence,
+		"protocolVersion":  request.ProtocolVersion,
+	}).Debug("Starting Event stream")
+
+	s.bridge.SetCurrentPlatform(request.ClientPlatform)
 
-	if s.isStreamingEvents() {
-		return status.Errorf(codes.AlreadyExists, "the service is already streaming") // TO-DO GODT-1667 decide if we want to kill the existing stream.
+	return s.runEventStream(request, server)
+}
+
+// runEventStream forwards events to the given stream until the stream is stopped or closed by the client.
+// As the gRPC client is the GUI, bridge quits when the client closes the stream, unless other gRPC streams are still
+// open. WebSocket streams do not keep bridge running, as their clients are not the GUI.
+func (s *Service) runEventStream(request *EventStreamRequest, server Bridge_RunEventStreamServer) error {
+	if err := s.streamEvents(request, server); !errors.Is(err, errStreamClosedByClient) {
+		return err
 	}
 
-	s.bridge.SetCurrentPlatform(request.ClientPlatform)
+	if s.isGUIStreamingEvents() {
+		s.log.Debug("Other gRPC event streams are still open, not quitting")
+		return nil
+	}
 
-	s.createEventStreamChannel()
-	s.eventStreamDoneCh = make(chan struct{})
+	return s.quit()
+}
 
-	// TO-DO GODT-1667 We should have a safer we to close this channel? What if an event occur while we are closing?
-	defer func() {
-		close(s.eventStreamCh)
-		s.deleteEventStreamChannel()
-		close(s.eventStreamDoneCh)
-		s.eventStreamDoneCh = nil
-	}()
+// streamEvents forwards events to the given stream until the stream is stopped, or closed by the client in which case
+// errStreamClosedByClient is returned. If the request has a last seen sequence, the events sent after it are replayed
+// first. If the request has categories, only the events of these categories are forwarded. Only the events known to
+// the protocol version negotiated with the client are forwarded, in the form this version expects.
+func (s *Service) streamEvents(request *EventStreamRequest, server Bridge_RunEventStreamServer) error {
+	lastSeenSequence := request.LastSeenSequence
+	protocolVersion := negotiateEventProtocol(request.ProtocolVersion)
+
+	// The stream is registered under the queue lock, so that every event is either taken from the queue or history,
+	// or sent to the stream, but not both.
+	s.eventQueueMutex.Lock()
+
+	_, webSocket := server.(*webSocketEventStream)
+
+	stream := s.addActiveStream(request.ClientPlatform, request.Categories, protocolVersion, webSocket)
+
+	// if events occurred before streaming started, they've been queued. Now that the stream is registered
+	// we can flush the queued. The queue is taken at once so that a stalled client does not hold the queue lock.
+	queued, dropped := s.eventQueue, s.eventQueueDropped
+	s.eventQueue, s.eventQueueDropped = nil, 0
+
+	// The queued events are part of the history, so they are replayed along with the events other streams received.
+	var resyncRequired bool
+	if lastSeenSequence > 0 {
+		queued, resyncRequired = s.getEventHistoryLocked(lastSeenSequence)
+	}
+
+	s.eventQueueMutex.Unlock()
+
+	defer s.removeActiveStream(stream)
+
+	queued = xslices.Filter(queued, stream.accepts)
+
+	// Let the client know first if some events were lost, so that it can refresh its state.
+	switch {
+	case resyncRequired:
+		queued = append([]*StreamEvent{NewResyncRequiredEvent()}, queued...)
+
+	case lastSeenSequence == 0 && dropped > 0:
+		queued = append([]*StreamEvent{NewEventsDroppedEvent(dropped)}, queued...)
+	}
 
-	// if events occurred before streaming started, they've been queued. Now that the stream channel is available
-	// we can flush the queued
 	go func() {
 		defer async.HandlePanic(s.panicHandler)
 
-		s.eventQueueMutex.Lock()
-		defer s.eventQueueMutex.Unlock()
-		for _, event := range s.eventQueue {
-			s.eventStreamCh <- event
-		}
-
-		s.eventQueue = nil
+		stream.flush(queued)
 	}()
 
-	for {
-		select {
-		case <-s.eventStreamDoneCh:
-			s.log.Debug("Stop Event stream")
-			return nil
+	heartbeat := newHeartbeat(time.Duration(s.eventStreamHeartbeat.Load()))
+	defer heartbeat.stop()
+
+	send := func(events ...*StreamEvent) error {
+		for _, event := range events {
+			if event = event.forProtocol(stream.protocolVersion); event == nil {
+				continue
+			}
 
-		case event := <-s.eventStreamCh:
 			s.log.WithField("event", event).Debug("Sending event")
 			if err := server.Send(event); err != nil {
 				s.log.Debug("Stop Event stream")
 				return err
 			}
+
+			stream.onEventSent()
+			heartbeat.reset()
+		}
+
+		return nil
+	}
+
+	quiet := newQuietPeriod(time.Duration(s.eventStreamQuietPeriod.Load()))
+	defer quiet.release()
+
+	for {
+		select {
+		case reason := <-stream.stopCh:
+			s.log.WithField("reason", reason).Debug("Stop Event stream")
+
+			// Forward the events buffered before the stop first, so that a final event such as a login finished
+			// event is not lost on a clean stop.
+			if err := s.drainEventStream(stream, quiet, send); err != nil {
+				return err
+			}
+
+			if err := send(quiet.release()...); err != nil {
+				return err
+			}
+
+			// Let the client know the stream is ending on purpose, so it can tell a clean stop from a crash.
+			return send(NewStreamEndingEvent(reason))
+
+		case event := <-stream.eventCh:
+			if quiet.hold(event) {
+				continue
+			}
+
+			if err := send(event); err != nil {
+				return err
+			}
+
+		case <-quiet.done():
+			if err := send(quiet.release()...); err != nil {
+				return err
+			}
+
+		case <-heartbeat.due():
+			if err := send(NewHeartbeatEvent()); err != nil {
+				return err
+			}
+
 		case <-server.Context().Done():
 			s.log.Debug("Client closed the stream, exiting")
-			return s.quit()
+			return errStreamClosedByClient
 		}
 	}
 }
 
-// StopEventStream stops the event stream.
-func (s *Service) StopEventStream(_ context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.stopEventStream()
+// ListActiveStreams returns diagnostic information about the event streams currently open.
+func (s *Service) ListActiveStreams(_ context.Context, _ *emptypb.Empty) (*ActiveStreamList, error) {
+	s.activeStreamsLock.Lock()
+	defer s.activeStreamsLock.Unlock()
+
+	list := &ActiveStreamList{Streams: make([]*ActiveStreamInfo, 0, len(s.activeStreams))}
+	for _, stream := range s.activeStreams {
+		list.Streams = append(list.Streams, stream.info())
+	}
+
+	slices.SortFunc(list.Streams, func(a, b *ActiveStreamInfo) bool { return a.StartTimeMs < b.StartTimeMs })
+
+	return list, nil
 }
 
-func (s *Service) stopEventStream() error {
-	s.eventStreamChMutex.RLock()
-	defer s.eventStreamChMutex.RUnlock()
+// SetEventStreamBuffer sets how many events are buffered for each event stream started afterwards, and what SendEvent
+// does when a buffer is full because its client is not reading events. The timeout only applies to
+// EventSendPolicyBlockWithTimeout. Non-positive sizes and timeouts select the defaults.
+func (s *Service) SetEventStreamBuffer(size int, policy EventSendPolicy, timeout time.Duration) {
+	s.eventStreamBufferSize.Store(int64(size))
+	s.eventSendPolicy.Store(int32(policy))
+	s.eventSendTimeout.Store(int64(timeout))
+}
 
-	if s.eventStreamCh == nil {
+// SetEventStreamHeartbeatInterval sets how long event streams started afterwards may be idle before a heartbeat event
+// is sent, so that clients can tell an idle stream from a dead one. A non-positive interval disables heartbeats.
+func (s *Service) SetEventStreamHeartbeatInterval(interval time.Duration) {
+	s.eventStreamHeartbeat.Store(int64(interval))
+}
+
+// SetEventStreamQuietPeriod sets for how long after an event stream starts non-critical events are held back, only
+// keeping the latest of the high-frequency ones such as sync progress. A non-positive period, the default, disables it.
+func (s *Service) SetEventStreamQuietPeriod(period time.Duration) {
+	s.eventStreamQuietPeriod.Store(int64(period))
+}
+
+// SetEventStreamDrainTimeout sets for how long a stream which is stopped keeps forwarding the events which were already
+// buffered for it before ending. A non-positive timeout selects the default.
+func (s *Service) SetEventStreamDrainTimeout(timeout time.Duration) {
+	s.eventStreamDrainTimeout.Store(int64(timeout))
+}
+
+// drainEventStream sends the events buffered for the stream, until none is left or the drain timeout expires.
+// The events left when it times out are queued again by removeActiveStream if no other stream is open.
+func (s *Service) drainEventStream(stream *activeStream, quiet *quietPeriod, send func(...*StreamEvent) error) error {
+	timeout := time.Duration(s.eventStreamDrainTimeout.Load())
+	if timeout <= 0 {
+		timeout = defaultEventStreamDrainTimeout
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	for time.Now().Before(deadline) {
+		select {
+		case event := <-stream.eventCh:
+			if quiet.hold(event) {
+				continue
+			}
+
+			if err := send(event); err != nil {
+				return err
+			}
+
+		default:
+			return nil
+		}
+	}
+
+	s.log.WithField("stream", stream.id).Warn("Timed out forwarding the buffered events of the stopped event stream")
+
+	return nil
+}
+
+// StopEventStream stops the event stream with the given ID, as listed by ListActiveStreams, or all the event streams
+// if no ID is given.
+func (s *Service) StopEventStream(_ context.Context, request *StopEventStreamRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.stopEventStreamWithID(request.StreamId, streamEndingReasonStopped)
+}
+
+// stopEventStream stops all the event streams. The reason is sent to the clients in a final StreamEndingEvent.
+func (s *Service) stopEventStream(reason string) error {
+	return s.stopEventStreamWithID("", reason)
+}
+
+// stopEventStreamWithID stops the event stream with the given ID, or all the event streams if the ID is empty.
+// The reason is sent to the clients in a final StreamEndingEvent.
+func (s *Service) stopEventStreamWithID(streamID, reason string) error {
+	streams := xslices.Filter(s.getActiveStreams(), func(stream *activeStream) bool {
+		return streamID == "" || stream.id == streamID
+	})
+
+	if len(streams) == 0 {
 		return status.Errorf(codes.NotFound, "The service is not streaming")
 	}
 
-	s.eventStreamDoneCh <- struct{}{}
+	for _, stream := range streams {
+		stream.stop(reason)
+	}
 
 	return nil
 }
 
-// SendEvent sends an event to the via the gRPC event stream.
+// SendEvent sends an event to all the event streams.
+// If all the streams exit while the event is being sent, e.g. because they are being stopped, the event is queued as if
+// no stream had been open, so that the next stream receives it.
+// If a debounce interval is set, high-frequency events are held back and only the latest one of each key is sent.
 func (s *Service) SendEvent(event *StreamEvent) error {
-	if !s.isStreamingEvents() { // nobody is connected to the event stream, we queue events
-		s.queueEvent(event)
+	return s.SendEventCtx(context.Background(), event)
+}
+
+// SendEventCtx sends an event to all the event streams, like SendEvent.
+// If the context is done while waiting for a stream with a full buffer, the send is aborted and the context error is
+// returned. The streams that had room for the event still receive it.
+func (s *Service) SendEventCtx(ctx context.Context, event *StreamEvent) error {
+	if s.debounceEvent(event) {
 		return nil
 	}
 
-	s.eventStreamCh <- event
+	return s.sendEvent(ctx, event)
+}
 
-	return nil
+// sendEvent sends the given event to the active streams, or queues it if there are none.
+func (s *Service) sendEvent(ctx context.Context, event *StreamEvent) error {
+	s.notifications.forward(event)
+
+	policy := EventSendPolicy(s.eventSendPolicy.Load())
+
+	timeout := time.Duration(s.eventSendTimeout.Load())
+	if timeout <= 0 {
+		timeout = defaultEventStreamSendTimeout
+	}
+
+	for attempt := 0; ; attempt++ {
+		// The queue lock is held while checking for streams so that a stream starting concurrently flushes the event.
+		s.eventQueueMutex.Lock()
+
+		if attempt == 0 {
+			s.addEventToHistoryLocked(event)
+			s.trackEventAck(event)
+		}
+
+		streams := s.getActiveStreams()
+		if len(streams) == 0 { // nobody is connected to the event stream, we queue events
+			s.queueEventLocked(event)
+			s.eventQueueMutex.Unlock()
+
+			return nil
+		}
+
+		s.eventQueueMutex.Unlock()
+
+		var (
+			errs   *multierror.Error
+			exited int
+		)
+
+		for _, stream := range streams {
+			switch err := stream.send(ctx, event, policy, timeout); {
+			case errors.Is(err, errStreamExited):
+				exited++
+
+			case err != nil && errors.Is(err, ctx.Err()):
+				s.log.WithField("stream", stream.id).Debug("Event send was cancelled")
+
+			case err != nil:
+				s.log.WithError(err).WithField("stream", stream.id).Warn("Failed to send event")
+				errs = multierror.Append(errs, err)
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if exited < len(streams) {
+			return errs.ErrorOrNil()
+		}
+
+		s.log.Debug("All the event streams exited while sending the event, retrying")
+	}
 }
 
-// StartEventTest sends all the known event via gRPC.
+// StartEventTest sends all the known event via gRPC, but the stream control events, which would act on the streams of
+// the clients.
 func (s *Service) StartEventTest() error {
+	for _, event := range newTestEvents() {
+		if event.isStreamControl() {
+			continue
+		}
+
+		if err := s.SendEvent(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StartEventTestCategory sends all the known events of the given category via gRPC, but the stream control events.
+func (s *Service) StartEventTestCategory(category EventCategory) error {
+	for _, event := range newTestEvents() {
+		if event.Category() != category || event.isStreamControl() {
+			continue
+		}
+
+		if err := s.SendEvent(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newTestEvents returns one event of every known kind. StartEventTest sends those which are not stream control events.
+func newTestEvents() []*StreamEvent {
 	const dummyAddress = "dummy@proton.me"
-	events := []*StreamEvent{
+
+	return []*StreamEvent{
 		// app
 		NewInternetStatusEvent(true),
 		NewToggleAutostartFinishedEvent(),
@@ -122,6 +445,17 @@ func (s *Service) StartEventTest() error {
 		NewReportBugSuccessEvent(),
 		NewReportBugErrorEvent(),
 		NewShowMainWindowEvent(),
+		NewConfigMigratedEvent("2.4.0", "3.0.0", true),
+		NewServerRateLimitedEvent(10*time.Second, "/core/v4/events"),
+		NewKeyGenerationRateLimitedEvent(30 * time.Second),
+		NewLoggingInfoEvent("info", "/path/to/bridge.log"),
+		NewAnotherInstanceRunningEvent(4242),
+		NewEventsDroppedEvent(3),
+		NewCertRegeneratedEvent(true, "3f2a9c0d5e6b7a8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e"),
+		NewStreamEndingEvent(streamEndingReasonStopped),
+		NewHeartbeatEvent(),
+		NewResyncRequiredEvent(),
+		NewShutdownPendingSendsEvent(2),
 
 		// login
 		NewLoginError(LoginErrorType_FREE_USER, "error"),
@@ -170,51 +504,407 @@ func (s *Service) StartEventTest() error {
 		NewMailAddressChangeEvent(dummyAddress),
 		NewMailAddressChangeLogoutEvent(dummyAddress),
 		NewMailApiCertIssue(),
+		NewMailSendCompletedEvent("userID", "messageID", 1500, 2048, 3),
+		NewMailSendPriorInProgressEvent("userID"),
+		NewMailScheduledSendDeliveredEvent("userID", "messageID"),
+		NewMailSentFromAddressEvent("userID", "messageID", dummyAddress),
+		NewMailMessageTruncatedEvent("userID", "messageID", "body could not be decrypted", 1),
+		NewMailSendDedupedEvent("userID", "messageID", "subject", dummyAddress),
 
 		// user
 		NewUserToggleSplitModeFinishedEvent("userID"),
 		NewUserDisconnectedEvent("username"),
 		NewUserChangedEvent("userID"),
 		NewUsedBytesChangedEvent("userID", 1000),
+		NewUploadProgressEvent("userID", 5, 10),
+		NewSyncProgressEvent("userID", 0.5, 1000, 1000, 5, 10),
+		NewCorruptCacheEntryEvent("userID", "messageID", true),
+		NewAccountAutoDisabledEvent("userID", "reason"),
 	}
-
-	for _, event := range events {
-		if err := s.SendEvent(event); err != nil {
-			return err
-		}
-	}
-
-	return nil
 }
 
 func (s *Service) queueEvent(event *StreamEvent) {
 	s.eventQueueMutex.Lock()
 	defer s.eventQueueMutex.Unlock()
 
+	s.queueEventLocked(event)
+}
+
+// queueEventLocked queues the event. The queue lock must be held.
+func (s *Service) queueEventLocked(event *StreamEvent) {
 	if event.isInternetStatus() {
 		s.eventQueue = append(filterOutInternetStatusEvents(s.eventQueue), event)
 	} else {
 		s.eventQueue = append(s.eventQueue, event)
 	}
+
+	// Drop the oldest events if the queue overflows. The client is told how many were dropped when it connects.
+	if overflow := len(s.eventQueue) - s.eventQueueMaxSize; s.eventQueueMaxSize > 0 && overflow > 0 {
+		s.log.WithField("count", overflow).Warn("Event queue is full, dropping oldest events")
+
+		s.eventQueue = slices.Delete(s.eventQueue, 0, overflow)
+		s.eventQueueDropped += overflow
+	}
+}
+
+// addEventToHistoryLocked gives the event the next sequence and keeps it in the history, dropping the oldest events if
+// the history overflows.
+func (s *Service) addEventToHistoryLocked(event *StreamEvent) {
+	s.eventSequence++
+	event.Sequence = s.eventSequence
+
+	if s.eventHistoryMax <= 0 {
+		return
+	}
+
+	s.eventHistory = append(s.eventHistory, event)
+
+	if overflow := len(s.eventHistory) - s.eventHistoryMax; overflow > 0 {
+		s.eventHistory = slices.Delete(s.eventHistory, 0, overflow)
+	}
+}
+
+// getEventHistoryLocked returns the events sent after the given sequence which are still in the history, and whether
+// some of them are no longer available, e.g. because the history overflowed or bridge restarted since.
+func (s *Service) getEventHistoryLocked(lastSeenSequence uint64) ([]*StreamEvent, bool) {
+	// The client saw events bridge did not send, so it must be from a previous run of bridge.
+	if lastSeenSequence > s.eventSequence {
+		return slices.Clone(s.eventHistory), true
+	}
+
+	idx, _ := slices.BinarySearchFunc(s.eventHistory, lastSeenSequence+1, func(event *StreamEvent, sequence uint64) int {
+		switch {
+		case event.Sequence < sequence:
+			return -1
+
+		case event.Sequence > sequence:
+			return 1
+
+		default:
+			return 0
+		}
+	})
+
+	missed := slices.Clone(s.eventHistory[idx:])
+
+	oldest := s.eventSequence + 1
+	if len(s.eventHistory) > 0 {
+		oldest = s.eventHistory[0].Sequence
+	}
+
+	return missed, lastSeenSequence+1 < oldest
 }
 
 func (s *Service) isStreamingEvents() bool {
-	s.eventStreamChMutex.RLock()
-	defer s.eventStreamChMutex.RUnlock()
+	s.activeStreamsLock.Lock()
+	defer s.activeStreamsLock.Unlock()
+
+	return len(s.activeStreams) > 0
+}
+
+// isGUIStreamingEvents returns whether a gRPC client, as opposed to a WebSocket client, is streaming events.
+func (s *Service) isGUIStreamingEvents() bool {
+	s.activeStreamsLock.Lock()
+	defer s.activeStreamsLock.Unlock()
+
+	for _, stream := range s.activeStreams {
+		if !stream.webSocket {
+			return true
+		}
+	}
+
+	return false
+}
+
+// heartbeat signals when an event stream has been idle for the given interval. A nil heartbeat is never due.
+type heartbeat struct {
+	interval time.Duration
+	timer    *time.Timer
+}
+
+func newHeartbeat(interval time.Duration) *heartbeat {
+	if interval <= 0 {
+		return nil
+	}
+
+	return &heartbeat{interval: interval, timer: time.NewTimer(interval)}
+}
+
+// due returns a channel receiving a value once the stream has been idle for the interval.
+func (h *heartbeat) due() <-chan time.Time {
+	if h == nil {
+		return nil
+	}
+
+	return h.timer.C
+}
+
+// reset restarts the interval, as an event was just sent.
+func (h *heartbeat) reset() {
+	if h == nil {
+		return
+	}
+
+	if !h.timer.Stop() {
+		select {
+		case <-h.timer.C:
+		default:
+		}
+	}
+
+	h.timer.Reset(h.interval)
+}
+
+func (h *heartbeat) stop() {
+	if h != nil {
+		h.timer.Stop()
+	}
+}
+
+// activeStream is an open event stream, and holds diagnostic information about it.
+type activeStream struct {
+	id             string
+	clientPlatform string
+	startTime      time.Time
+	categories     []EventCategory // The categories of events forwarded to the stream; all of them if empty.
+
+	protocolVersion uint32 // The version of the event protocol negotiated with the client.
+
+	webSocket bool // Whether the client streams events over WebSocket rather than gRPC.
+
+	eventCh chan *StreamEvent
+	stopCh  chan string
+	exitCh  chan struct{} // Closed once the stream stopped forwarding events.
+
+	unflushedCh chan []*StreamEvent // Receives the queued events which flush could not hand over to the stream.
+
+	// sendLock is held for reading while events are buffered, and for writing while the stream is closed, so that no
+	// event is buffered once the stream took back the events it did not forward.
+	sendLock sync.RWMutex
+	closed   bool
+
+	lock         sync.Mutex
+	eventsSent   int64
+	lastActivity time.Time
+}
+
+func (s *Service) addActiveStream(
+	clientPlatform string,
+	categories []EventCategory,
+	protocolVersion uint32,
+	webSocket bool,
+) *activeStream {
+	s.activeStreamsLock.Lock()
+	defer s.activeStreamsLock.Unlock()
+
+	bufferSize := int(s.eventStreamBufferSize.Load())
+	if bufferSize <= 0 {
+		bufferSize = defaultEventStreamBufferSize
+	}
 
-	return s.eventStreamCh != nil
+	now := time.Now()
+	stream := &activeStream{
+		id:              uuid.NewString(),
+		clientPlatform:  clientPlatform,
+		categories:      categories,
+		protocolVersion: protocolVersion,
+		webSocket:       webSocket,
+		startTime:       now,
+		eventCh:         make(chan *StreamEvent, bufferSize),
+		stopCh:          make(chan string),
+		exitCh:          make(chan struct{}),
+		unflushedCh:     make(chan []*StreamEvent, 1),
+		lastActivity:    now,
+	}
+
+	if s.activeStreams == nil {
+		s.activeStreams = make(map[string]*activeStream)
+	}
+
+	s.activeStreams[stream.id] = stream
+
+	return stream
+}
+
+// removeActiveStream unregisters the stream, then releases anyone still trying to send to it.
+// If it was the last stream, the events it did not forward are queued again for the next stream.
+func (s *Service) removeActiveStream(stream *activeStream) {
+	s.eventQueueMutex.Lock()
+	defer s.eventQueueMutex.Unlock()
+
+	s.activeStreamsLock.Lock()
+	delete(s.activeStreams, stream.id)
+	last := len(s.activeStreams) == 0
+	s.activeStreamsLock.Unlock()
+
+	pending := stream.close()
+
+	if last {
+		for _, event := range pending {
+			s.queueEventLocked(event)
+		}
+	}
+}
+
+// getActiveStreams returns a snapshot of the open event streams.
+func (s *Service) getActiveStreams() []*activeStream {
+	s.activeStreamsLock.Lock()
+	defer s.activeStreamsLock.Unlock()
+
+	return maps.Values(s.activeStreams)
+}
+
+// flush hands the events queued before the stream started over to the stream, in order. It must be called exactly
+// once for each stream.
+func (a *activeStream) flush(events []*StreamEvent) {
+	for idx, event := range events {
+		if !a.push(event) {
+			a.unflushedCh <- events[idx:]
+			return
+		}
+	}
+
+	a.unflushedCh <- nil
 }
 
-func (s *Service) createEventStreamChannel() {
-	s.eventStreamChMutex.Lock()
-	defer s.eventStreamChMutex.Unlock()
+// push hands the event over to the stream, waiting for room in its buffer. It returns false if the stream exited
+// before receiving it.
+func (a *activeStream) push(event *StreamEvent) bool {
+	a.sendLock.RLock()
+	defer a.sendLock.RUnlock()
+
+	if a.closed {
+		return false
+	}
+
+	select {
+	case a.eventCh <- event:
+		return true
 
-	s.eventStreamCh = make(chan *StreamEvent)
+	case <-a.exitCh:
+		return false
+	}
 }
 
-func (s *Service) deleteEventStreamChannel() {
-	s.eventStreamChMutex.Lock()
-	defer s.eventStreamChMutex.Unlock()
+// accepts returns whether the event is of a category forwarded to the stream, and known to its protocol version.
+func (a *activeStream) accepts(event *StreamEvent) bool {
+	if event.protocolVersion() > a.protocolVersion {
+		return false
+	}
+
+	return len(a.categories) == 0 || event.isStreamControl() || slices.Contains(a.categories, event.Category())
+}
 
-	s.eventStreamCh = nil
+// send buffers the event for the stream, applying the given policy if the buffer is full. It returns errStreamExited
+// if the stream exited before taking the event, or the context error if the context is done while waiting for room.
+// Events of categories not forwarded to the stream are ignored.
+func (a *activeStream) send(ctx context.Context, event *StreamEvent, policy EventSendPolicy, timeout time.Duration) error {
+	if !a.accepts(event) {
+		return nil
+	}
+
+	a.sendLock.RLock()
+	defer a.sendLock.RUnlock()
+
+	if a.closed {
+		return errStreamExited
+	}
+
+	select {
+	case a.eventCh <- event:
+		return nil
+
+	default:
+	}
+
+	if policy == EventSendPolicyDropOldest {
+		for {
+			select {
+			case a.eventCh <- event:
+				return nil
+
+			case <-a.exitCh:
+				return errStreamExited
+
+			default:
+			}
+
+			// Only drop an event if the buffer is still full; the stream may have taken one in the meantime.
+			select {
+			case dropped := <-a.eventCh:
+				logrus.WithField("stream", a.id).WithField("event", dropped).Warn("Event stream buffer is full, dropping oldest event")
+
+			default:
+			}
+		}
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case a.eventCh <- event:
+		return nil
+
+	case <-a.exitCh:
+		return errStreamExited
+
+	case <-ctx.Done():
+		return ctx.Err()
+
+	case <-timer.C:
+		return ErrEventStreamFull
+	}
+}
+
+// close releases anyone trying to send to the stream, which must have stopped forwarding events, and returns the
+// events it did not forward, in order.
+func (a *activeStream) close() []*StreamEvent {
+	close(a.exitCh)
+
+	pending := <-a.unflushedCh
+
+	a.sendLock.Lock()
+	defer a.sendLock.Unlock()
+
+	a.closed = true
+
+	for {
+		select {
+		case event := <-a.eventCh:
+			pending = append(pending, event)
+
+		default:
+			return pending
+		}
+	}
+}
+
+// stop asks the stream to stop for the given reason, unless it already exited.
+func (a *activeStream) stop(reason string) {
+	select {
+	case a.stopCh <- reason:
+	case <-a.exitCh:
+	}
+}
+
+func (a *activeStream) onEventSent() {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.eventsSent++
+	a.lastActivity = time.Now()
+}
+
+func (a *activeStream) info() *ActiveStreamInfo {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return &ActiveStreamInfo{
+		Id:             a.id,
+		ClientPlatform: a.clientPlatform,
+		StartTimeMs:    a.startTime.UnixMilli(),
+		EventsSent:     a.eventsSent,
+		LastActivityMs: a.lastActivity.UnixMilli(),
+	}
 }
@@ -0,0 +1,881 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestService_StopEventStreamSendsEndingEvent(t *testing.T) {
+	for _, reason := range []string{streamEndingReasonStopped, streamEndingReasonShutdown} {
+		s := newTestService()
+		server, errCh := startTestEventStream(t, s)
+
+		require.NoError(t, s.SendEvent(NewShowMainWindowEvent()))
+		require.NoError(t, s.stopEventStream(reason))
+		require.NoError(t, <-errCh)
+
+		received := server.received()
+		require.Len(t, received, 2)
+		require.NotNil(t, received[0].GetApp().GetShowMainWindow())
+		require.Equal(t, reason, received[1].GetApp().GetStreamEnding().GetReason())
+		require.False(t, s.isStreamingEvents())
+	}
+}
+
+func TestService_ListActiveStreams(t *testing.T) {
+	s := newTestService()
+
+	list, err := s.ListActiveStreams(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Empty(t, list.Streams)
+
+	before := time.Now()
+	_, errCh := startTestEventStream(t, s)
+
+	list, err = s.ListActiveStreams(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Len(t, list.Streams, 1)
+
+	stream := list.Streams[0]
+	require.NotEmpty(t, stream.Id)
+	require.Equal(t, "test", stream.ClientPlatform)
+	require.GreaterOrEqual(t, stream.StartTimeMs, before.UnixMilli())
+	require.Zero(t, stream.EventsSent)
+
+	// Sending an event updates the stream activity.
+	require.NoError(t, s.SendEvent(NewShowMainWindowEvent()))
+	require.Eventually(t, func() bool {
+		list, err := s.ListActiveStreams(context.Background(), &emptypb.Empty{})
+		require.NoError(t, err)
+
+		return len(list.Streams) == 1 && list.Streams[0].EventsSent == 1
+	}, time.Second, time.Millisecond)
+
+	// Closing the stream removes it from the list.
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+
+	list, err = s.ListActiveStreams(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Empty(t, list.Streams)
+}
+
+func TestService_MultipleEventStreams(t *testing.T) {
+	s := newTestService()
+
+	first, firstErrCh := startTestEventStream(t, s)
+	second, secondErrCh := startTestEventStream(t, s)
+	require.Eventually(t, func() bool { return len(s.getActiveStreams()) == 2 }, time.Second, time.Millisecond)
+
+	// Both streams receive the events.
+	require.NoError(t, s.SendEvent(NewShowMainWindowEvent()))
+
+	for _, server := range []*fakeEventStreamServer{first, second} {
+		require.Eventually(t, func() bool { return len(server.received()) == 1 }, time.Second, time.Millisecond)
+		require.NotNil(t, server.received()[0].GetApp().GetShowMainWindow())
+	}
+
+	// A single stream can be stopped.
+	list, err := s.ListActiveStreams(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Len(t, list.Streams, 2)
+
+	_, err = s.StopEventStream(context.Background(), &StopEventStreamRequest{StreamId: list.Streams[0].Id})
+	require.NoError(t, err)
+
+	var (
+		stopped, running *fakeEventStreamServer
+		runningErrCh     <-chan error
+	)
+
+	select {
+	case err := <-firstErrCh:
+		require.NoError(t, err)
+		stopped, running, runningErrCh = first, second, secondErrCh
+
+	case err := <-secondErrCh:
+		require.NoError(t, err)
+		stopped, running, runningErrCh = second, first, firstErrCh
+	}
+
+	require.Equal(t, streamEndingReasonStopped, stopped.received()[1].GetApp().GetStreamEnding().GetReason())
+
+	// The other stream still receives the events.
+	require.NoError(t, s.SendEvent(NewUserChangedEvent("userID")))
+	require.Eventually(t, func() bool { return len(running.received()) == 2 }, time.Second, time.Millisecond)
+	require.Equal(t, "userID", running.received()[1].GetUser().GetUserChanged().GetUserID())
+	require.Len(t, stopped.received(), 2)
+
+	// Unknown streams cannot be stopped.
+	_, err = s.StopEventStream(context.Background(), &StopEventStreamRequest{StreamId: "unknown"})
+	require.Error(t, err)
+
+	// All the streams are stopped if no ID is given.
+	_, err = s.StopEventStream(context.Background(), &StopEventStreamRequest{})
+	require.NoError(t, err)
+	require.NoError(t, <-runningErrCh)
+	require.False(t, s.isStreamingEvents())
+}
+
+func newTestService() *Service {
+	return &Service{log: logrus.WithField("pkg", "grpc/test")}
+}
+
+// startTestEventStream runs the event stream of the service in a goroutine and waits for it to be started.
+func startTestEventStream(t *testing.T, s *Service) (*fakeEventStreamServer, <-chan error) {
+	server := newFakeEventStreamServer()
+
+	return server, startTestEventStreamWithServer(t, s, server)
+}
+
+// startTestEventStreamWithServer is like startTestEventStream, but the events are sent to the given server.
+func startTestEventStreamWithServer(t *testing.T, s *Service, server *fakeEventStreamServer) <-chan error {
+	return startTestEventStreamFrom(t, s, server, 0)
+}
+
+// fakeEventStreamServer is a Bridge_RunEventStreamServer recording all the events sent to the client.
+type fakeEventStreamServer struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	lock   sync.Mutex
+	events []*StreamEvent
+
+	// If set, Send waits for it to be closed, as for a client which stopped reading events.
+	stalled chan struct{}
+}
+
+func newFakeEventStreamServer() *fakeEventStreamServer {
+	return &fakeEventStreamServer{ctx: context.Background()}
+}
+
+func (f *fakeEventStreamServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeEventStreamServer) Send(event *StreamEvent) error {
+	if f.stalled != nil {
+		<-f.stalled
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.events = append(f.events, event)
+
+	return nil
+}
+
+func (f *fakeEventStreamServer) received() []*StreamEvent {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.events
+}
+
+func TestService_EventQueueOverflow(t *testing.T) {
+	s := newTestService()
+	s.eventQueueMaxSize = 3
+
+	// Nobody is consuming events, so they are queued and the oldest ones are dropped.
+	for i := 0; i < 5; i++ {
+		require.NoError(t, s.SendEvent(NewUserChangedEvent(fmt.Sprint(i))))
+	}
+
+	// Once the consumer is there, it is told how many events were dropped before receiving the remaining ones.
+	server, errCh := startTestEventStream(t, s)
+
+	require.Eventually(t, func() bool { return len(server.received()) == 4 }, time.Second, time.Millisecond)
+
+	received := server.received()
+	require.Equal(t, int32(2), received[0].GetApp().GetEventsDropped().GetCount())
+
+	for i, event := range received[1:] {
+		require.Equal(t, fmt.Sprint(i+2), event.GetUser().GetUserChanged().GetUserID())
+	}
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+
+	// The dropped count is only reported once.
+	require.NoError(t, s.SendEvent(NewShowMainWindowEvent()))
+
+	server, errCh = startTestEventStream(t, s)
+
+	require.Eventually(t, func() bool { return len(server.received()) == 1 }, time.Second, time.Millisecond)
+	require.NotNil(t, server.received()[0].GetApp().GetShowMainWindow())
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_EventQueueUnbounded(t *testing.T) {
+	s := newTestService()
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, s.SendEvent(NewShowMainWindowEvent()))
+	}
+
+	require.Len(t, s.eventQueue, 5)
+	require.Zero(t, s.eventQueueDropped)
+}
+
+func TestService_EventStreamQuietPeriod(t *testing.T) {
+	s := newTestService()
+	s.SetEventStreamQuietPeriod(time.Hour)
+
+	server, errCh := startTestEventStream(t, s)
+
+	for _, event := range []*StreamEvent{
+		NewSyncStartedEvent("user1"),
+		NewSyncProgressEvent("user1", 0.1, 1, 9, 0, 0),
+		NewSyncProgressEvent("user2", 0.1, 1, 9, 0, 0),
+		NewUpdateForceEvent("3.0.0"),
+		NewSyncProgressEvent("user1", 0.5, 5, 5, 0, 0),
+		NewGenericErrorEvent(ErrorCode_UNKNOWN_ERROR),
+	} {
+		require.NoError(t, s.SendEvent(event))
+	}
+
+	// Critical events are delivered during the quiet period.
+	require.Eventually(t, func() bool { return len(server.received()) == 2 }, time.Second, time.Millisecond)
+
+	received := server.received()
+	require.Equal(t, "3.0.0", received[0].GetUpdate().GetForce().GetVersion())
+	require.Equal(t, ErrorCode_UNKNOWN_ERROR, received[1].GetGenericError().GetCode())
+
+	// The other events are held back, keeping only the latest progress of each user, until the stream stops.
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+
+	received = server.received()
+	require.Len(t, received, 6)
+	require.Equal(t, "user1", received[2].GetUser().GetSyncStartedEvent().GetUserID())
+	require.Equal(t, "user2", received[3].GetUser().GetSyncProgressEvent().GetUserID())
+	require.Equal(t, "user1", received[4].GetUser().GetSyncProgressEvent().GetUserID())
+	require.Equal(t, 0.5, received[4].GetUser().GetSyncProgressEvent().GetProgress())
+	require.NotNil(t, received[5].GetApp().GetStreamEnding())
+}
+
+func TestService_EventStreamQuietPeriodEnds(t *testing.T) {
+	s := newTestService()
+	s.SetEventStreamQuietPeriod(50 * time.Millisecond)
+
+	server, errCh := startTestEventStream(t, s)
+
+	require.NoError(t, s.SendEvent(NewSyncProgressEvent("user1", 0.1, 1, 9, 0, 0)))
+	require.NoError(t, s.SendEvent(NewSyncProgressEvent("user1", 0.2, 2, 8, 0, 0)))
+	require.Empty(t, server.received())
+
+	// Held events are delivered once the quiet period is over.
+	require.Eventually(t, func() bool { return len(server.received()) == 1 }, time.Second, time.Millisecond)
+	require.Equal(t, 0.2, server.received()[0].GetUser().GetSyncProgressEvent().GetProgress())
+
+	// After that, events are no longer held back.
+	require.NoError(t, s.SendEvent(NewSyncProgressEvent("user1", 0.3, 3, 7, 0, 0)))
+	require.Eventually(t, func() bool { return len(server.received()) == 2 }, time.Second, time.Millisecond)
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_SendEventCtx_Cancelled(t *testing.T) {
+	s := newTestService()
+	s.SetEventStreamBuffer(2, EventSendPolicyBlockWithTimeout, time.Minute)
+
+	server := newFakeEventStreamServer()
+	server.stalled = make(chan struct{})
+	errCh := startTestEventStreamWithServer(t, s, server)
+
+	// The stream takes the first event, then its buffer holds the next two.
+	for i := 0; i < 3; i++ {
+		require.NoError(t, s.SendEventCtx(context.Background(), NewUserChangedEvent(fmt.Sprint(i))))
+	}
+
+	// Once the buffer is full, the send blocks until the context is cancelled, well before the send timeout.
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	require.ErrorIs(t, s.SendEventCtx(ctx, NewUserChangedEvent("3")), context.Canceled)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	// A send with an expired context is aborted as well.
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, s.SendEventCtx(ctx, NewUserChangedEvent("4")), context.DeadlineExceeded)
+
+	// The aborted events are not delivered once the client reads again.
+	close(server.stalled)
+	require.Eventually(t, func() bool { return len(server.received()) == 3 }, time.Second, time.Millisecond)
+	require.Never(t, func() bool { return len(server.received()) > 3 }, 100*time.Millisecond, 10*time.Millisecond)
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_SendEventStalledClient_BlockWithTimeout(t *testing.T) {
+	s := newTestService()
+	s.SetEventStreamBuffer(2, EventSendPolicyBlockWithTimeout, 50*time.Millisecond)
+
+	server := newFakeEventStreamServer()
+	server.stalled = make(chan struct{})
+	errCh := startTestEventStreamWithServer(t, s, server)
+
+	// The stream takes the first event, then its buffer holds the next two.
+	for i := 0; i < 3; i++ {
+		require.NoError(t, s.SendEvent(NewUserChangedEvent(fmt.Sprint(i))))
+	}
+
+	// Once the buffer is full, sending gives up after the timeout.
+	start := time.Now()
+	require.ErrorIs(t, s.SendEvent(NewUserChangedEvent("3")), ErrEventStreamFull)
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+
+	// The buffered events are delivered once the client reads again.
+	close(server.stalled)
+	require.Eventually(t, func() bool { return len(server.received()) == 3 }, time.Second, time.Millisecond)
+
+	for i, event := range server.received() {
+		require.Equal(t, fmt.Sprint(i), event.GetUser().GetUserChanged().GetUserID())
+	}
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_SendEventStalledClient_DropOldest(t *testing.T) {
+	s := newTestService()
+	s.SetEventStreamBuffer(2, EventSendPolicyDropOldest, 0)
+
+	server := newFakeEventStreamServer()
+	server.stalled = make(chan struct{})
+	errCh := startTestEventStreamWithServer(t, s, server)
+
+	// The stream takes the first event, then stalls.
+	require.NoError(t, s.SendEvent(NewShowMainWindowEvent()))
+	require.Eventually(t, func() bool { return len(s.getActiveStreams()[0].eventCh) == 0 }, time.Second, time.Millisecond)
+
+	// Sending never blocks, the oldest buffered events are dropped instead.
+	start := time.Now()
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, s.SendEvent(NewUserChangedEvent(fmt.Sprint(i))))
+	}
+
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+
+	// The client gets the event it was sending when it stalled, then the most recent ones.
+	close(server.stalled)
+	require.Eventually(t, func() bool { return len(server.received()) == 3 }, time.Second, time.Millisecond)
+
+	received := server.received()
+	require.NotNil(t, received[0].GetApp().GetShowMainWindow())
+	require.Equal(t, "8", received[1].GetUser().GetUserChanged().GetUserID())
+	require.Equal(t, "9", received[2].GetUser().GetUserChanged().GetUserID())
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_EventStreamHeartbeat(t *testing.T) {
+	s := newTestService()
+	s.SetEventStreamHeartbeatInterval(20 * time.Millisecond)
+
+	server, errCh := startTestEventStream(t, s)
+
+	countHeartbeats := func() int {
+		return len(xslices.Filter(server.received(), func(event *StreamEvent) bool {
+			return event.GetApp().GetHeartbeat() != nil
+		}))
+	}
+
+	// Heartbeats are sent while the stream is idle.
+	require.Eventually(t, func() bool { return countHeartbeats() >= 2 }, time.Second, time.Millisecond)
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_EventStreamHeartbeatDisabled(t *testing.T) {
+	s := newTestService()
+	s.SetEventStreamHeartbeatInterval(0)
+
+	server, errCh := startTestEventStream(t, s)
+
+	time.Sleep(100 * time.Millisecond)
+	require.Empty(t, server.received())
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_StoppedStreamRequeuesPendingEvents(t *testing.T) {
+	s := newTestService()
+
+	server := newFakeEventStreamServer()
+	server.stalled = make(chan struct{})
+
+	errCh := startTestEventStreamWithServer(t, s, server)
+
+	// The first event is held by the stalled client, the others stay buffered.
+	for i := 0; i < 3; i++ {
+		require.NoError(t, s.SendEvent(NewUserChangedEvent(fmt.Sprint(i))))
+	}
+
+	require.Eventually(t, func() bool { return len(s.getActiveStreams()[0].eventCh) == 2 }, time.Second, time.Millisecond)
+
+	go func() { _ = s.stopEventStream(streamEndingReasonStopped) }()
+	close(server.stalled)
+	require.NoError(t, <-errCh)
+
+	// The events the stream did not forward are received by the next stream.
+	received := xslices.Filter(server.received(), func(event *StreamEvent) bool { return event.GetUser() != nil })
+
+	server, errCh = startTestEventStream(t, s)
+
+	require.Eventually(t, func() bool {
+		return len(received)+len(server.received()) == 3
+	}, time.Second, time.Millisecond)
+
+	for i, event := range append(received, server.received()...) {
+		require.Equal(t, fmt.Sprint(i), event.GetUser().GetUserChanged().GetUserID())
+	}
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_SendEventWhileStartingAndStoppingStreams(t *testing.T) {
+	s := newTestService()
+
+	const senders, eventsPerSender = 4, 200
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < senders; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < eventsPerSender; j++ {
+				require.NoError(t, s.SendEvent(NewUserChangedEvent(fmt.Sprintf("%v-%v", i, j))))
+			}
+		}(i)
+	}
+
+	sendersDone := make(chan struct{})
+
+	go func() {
+		wg.Wait()
+		close(sendersDone)
+	}()
+
+	var received []*StreamEvent
+
+	for done := false; !done; {
+		select {
+		case <-sendersDone:
+			done = true
+
+		default:
+		}
+
+		server, errCh := startTestEventStream(t, s)
+		require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+		require.NoError(t, <-errCh)
+
+		received = append(received, server.received()...)
+	}
+
+	// Every event was either received once by a stream or is still queued for the next one.
+	userIDs := make(map[string]int)
+
+	for _, event := range append(received, s.eventQueue...) {
+		if userChanged := event.GetUser().GetUserChanged(); userChanged != nil {
+			userIDs[userChanged.GetUserID()]++
+		}
+	}
+
+	require.Len(t, userIDs, senders*eventsPerSender)
+
+	for userID, count := range userIDs {
+		require.Equal(t, 1, count, userID)
+	}
+}
+
+func TestService_EventStreamReplaysMissedEvents(t *testing.T) {
+	s := newTestService()
+	s.eventHistoryMax = 10
+
+	server, errCh := startTestEventStream(t, s)
+
+	require.NoError(t, s.SendEvent(NewUserChangedEvent("0")))
+	require.Eventually(t, func() bool { return len(server.received()) == 1 }, time.Second, time.Millisecond)
+
+	lastSeen := server.received()[0].GetSequence()
+	require.NotZero(t, lastSeen)
+
+	// The client disconnects, missing some events.
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+
+	for i := 1; i < 4; i++ {
+		require.NoError(t, s.SendEvent(NewUserChangedEvent(fmt.Sprint(i))))
+	}
+
+	// Once reconnected, the client receives the missed events, then the new ones.
+	server = newFakeEventStreamServer()
+	errCh = startTestEventStreamFrom(t, s, server, lastSeen)
+
+	require.NoError(t, s.SendEvent(NewUserChangedEvent("4")))
+	require.Eventually(t, func() bool { return len(server.received()) == 4 }, time.Second, time.Millisecond)
+
+	for i, event := range server.received() {
+		require.Equal(t, fmt.Sprint(i+1), event.GetUser().GetUserChanged().GetUserID())
+		require.Equal(t, lastSeen+uint64(i)+1, event.GetSequence())
+	}
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_EventStreamReplaysEventsSentToOtherStreams(t *testing.T) {
+	s := newTestService()
+	s.eventHistoryMax = 10
+
+	// Another client keeps streaming while the first one is disconnected, so the events are not queued.
+	other, otherErrCh := startTestEventStream(t, s)
+
+	require.NoError(t, s.SendEvent(NewUserChangedEvent("0")))
+	require.NoError(t, s.SendEvent(NewUserChangedEvent("1")))
+	require.Eventually(t, func() bool { return len(other.received()) == 2 }, time.Second, time.Millisecond)
+
+	server := newFakeEventStreamServer()
+	errCh := startTestEventStreamFrom(t, s, server, other.received()[0].GetSequence())
+
+	require.Eventually(t, func() bool { return len(server.received()) == 1 }, time.Second, time.Millisecond)
+	require.Equal(t, "1", server.received()[0].GetUser().GetUserChanged().GetUserID())
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-otherErrCh)
+	require.NoError(t, <-errCh)
+}
+
+func TestService_EventStreamResyncRequired(t *testing.T) {
+	s := newTestService()
+	s.eventHistoryMax = 2
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, s.SendEvent(NewUserChangedEvent(fmt.Sprint(i))))
+	}
+
+	// The events after the first one are no longer all in the history.
+	server := newFakeEventStreamServer()
+	errCh := startTestEventStreamFrom(t, s, server, 1)
+
+	require.Eventually(t, func() bool { return len(server.received()) == 3 }, time.Second, time.Millisecond)
+
+	received := server.received()
+	require.NotNil(t, received[0].GetApp().GetResyncRequired())
+	require.Equal(t, "3", received[1].GetUser().GetUserChanged().GetUserID())
+	require.Equal(t, "4", received[2].GetUser().GetUserChanged().GetUserID())
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+
+	// A sequence from a previous run of bridge also requires a resync.
+	server = newFakeEventStreamServer()
+	errCh = startTestEventStreamFrom(t, s, server, 100)
+
+	require.Eventually(t, func() bool { return len(server.received()) == 3 }, time.Second, time.Millisecond)
+	require.NotNil(t, server.received()[0].GetApp().GetResyncRequired())
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_EventStreamCategories(t *testing.T) {
+	s := newTestService()
+
+	all, allErrCh := startTestEventStream(t, s)
+
+	filtered := newFakeEventStreamServer()
+	filteredErrCh := startTestEventStreamWithRequest(t, s, filtered, &EventStreamRequest{
+		ClientPlatform:  "test",
+		Categories:      []EventCategory{EventCategory_LOGIN_EVENTS, EventCategory_USER_EVENTS},
+		ProtocolVersion: eventProtocolLatest,
+	})
+	require.Eventually(t, func() bool { return len(s.getActiveStreams()) == 2 }, time.Second, time.Millisecond)
+
+	require.NoError(t, s.SendEvent(NewShowMainWindowEvent()))
+	require.NoError(t, s.SendEvent(NewLoginFinishedEvent("0", false)))
+	require.NoError(t, s.SendEvent(NewMailAddressChangeEvent("a@b.c")))
+	require.NoError(t, s.SendEvent(NewUserChangedEvent("0")))
+
+	require.Eventually(t, func() bool { return len(all.received()) == 4 }, time.Second, time.Millisecond)
+	require.Eventually(t, func() bool { return len(filtered.received()) == 2 }, time.Second, time.Millisecond)
+
+	require.NotNil(t, filtered.received()[0].GetLogin().GetFinished())
+	require.NotNil(t, filtered.received()[1].GetUser().GetUserChanged())
+
+	// Stream control events are always sent.
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-allErrCh)
+	require.NoError(t, <-filteredErrCh)
+
+	require.Len(t, filtered.received(), 3)
+	require.NotNil(t, filtered.received()[2].GetApp().GetStreamEnding())
+}
+
+func TestService_EventStreamCategoriesFilterQueuedEvents(t *testing.T) {
+	s := newTestService()
+
+	require.NoError(t, s.SendEvent(NewShowMainWindowEvent()))
+	require.NoError(t, s.SendEvent(NewUserChangedEvent("0")))
+	require.NoError(t, s.SendEvent(NewMailAddressChangeEvent("a@b.c")))
+
+	server := newFakeEventStreamServer()
+	errCh := startTestEventStreamWithRequest(t, s, server, &EventStreamRequest{
+		ClientPlatform:  "test",
+		Categories:      []EventCategory{EventCategory_MAIL_EVENTS},
+		ProtocolVersion: eventProtocolLatest,
+	})
+
+	require.Eventually(t, func() bool { return len(server.received()) == 1 }, time.Second, time.Millisecond)
+	require.NotNil(t, server.received()[0].GetMail().GetAddressChanged())
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-errCh)
+}
+
+func TestService_StopEventStreamDrainsBufferedEvents(t *testing.T) {
+	s := newTestService()
+
+	// The stream may see the stop before the event; repeat to cover both orders.
+	for i := 0; i < 20; i++ {
+		server, errCh := startTestEventStream(t, s)
+
+		require.NoError(t, s.SendEvent(NewLoginFinishedEvent("userID", false)))
+
+		_, err := s.StopEventStream(context.Background(), &StopEventStreamRequest{})
+		require.NoError(t, err)
+		require.NoError(t, <-errCh)
+
+		// The event is delivered before the stream ends, rather than queued for the next stream.
+		received := server.received()
+		require.Len(t, received, 2)
+		require.NotNil(t, received[0].GetLogin().GetFinished())
+		require.NotNil(t, received[1].GetApp().GetStreamEnding())
+		require.Empty(t, s.eventQueue)
+	}
+}
+
+func TestService_IsGUIStreamingEvents(t *testing.T) {
+	s := newTestService()
+
+	// WebSocket streams do not count as GUI streams, so closing the last gRPC stream quits bridge.
+	webSocket := s.addActiveStream(webSocketClientPlatform, nil, eventProtocolLatest, true)
+	webSocket.flush(nil)
+	require.True(t, s.isStreamingEvents())
+	require.False(t, s.isGUIStreamingEvents())
+
+	gui := s.addActiveStream("test", nil, eventProtocolLatest, false)
+	gui.flush(nil)
+	require.True(t, s.isGUIStreamingEvents())
+
+	s.removeActiveStream(gui)
+	require.False(t, s.isGUIStreamingEvents())
+
+	s.removeActiveStream(webSocket)
+	require.False(t, s.isStreamingEvents())
+}
+
+func TestService_DrainEventStreamTimeout(t *testing.T) {
+	s := newTestService()
+	s.SetEventStreamDrainTimeout(50 * time.Millisecond)
+
+	stream := s.addActiveStream("test", nil, eventProtocolLatest, false)
+
+	for i := 0; i < 5; i++ {
+		require.True(t, stream.push(NewShowMainWindowEvent()))
+	}
+
+	// The client takes its time reading the events: the stream does not wait for them all.
+	var sent int
+
+	require.NoError(t, s.drainEventStream(stream, newQuietPeriod(0), func(events ...*StreamEvent) error {
+		time.Sleep(30 * time.Millisecond)
+		sent += len(events)
+
+		return nil
+	}))
+
+	require.Equal(t, 2, sent)
+	require.Len(t, stream.eventCh, 3)
+}
+
+func TestService_EventStreamProtocolVersion(t *testing.T) {
+	s := newTestService()
+
+	v1 := newFakeEventStreamServer()
+	v1ErrCh := startTestEventStreamWithRequest(t, s, v1, &EventStreamRequest{
+		ClientPlatform:  "test",
+		ProtocolVersion: eventProtocolV1,
+	})
+
+	v2 := newFakeEventStreamServer()
+	v2ErrCh := startTestEventStreamWithRequest(t, s, v2, &EventStreamRequest{
+		ClientPlatform:  "test",
+		ProtocolVersion: eventProtocolV2,
+	})
+	require.Eventually(t, func() bool { return len(s.getActiveStreams()) == 2 }, time.Second, time.Millisecond)
+
+	require.NoError(t, s.SendEvent(NewShowMainWindowEvent().WithMetadata(map[string]string{"key": "value"})))
+	require.NoError(t, s.SendEvent(NewMailSendCompletedEvent("userID", "messageID", 1500, 2048, 3)))
+	require.NoError(t, s.SendEvent(NewUserChangedEvent("0")))
+	require.NoError(t, s.SendEvent(NewAccountAutoDisabledEvent("userID", "reason")))
+
+	require.Eventually(t, func() bool { return len(v2.received()) == 4 }, time.Second, time.Millisecond)
+	require.Eventually(t, func() bool { return len(v1.received()) == 2 }, time.Second, time.Millisecond)
+
+	require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+	require.NoError(t, <-v1ErrCh)
+	require.NoError(t, <-v2ErrCh)
+
+	// A v2 client receives all the events, with their sequence and metadata, and is told the stream is ending.
+	require.Len(t, v2.received(), 5)
+	require.Equal(t, "value", v2.received()[0].GetMetadata()["key"])
+	require.NotZero(t, v2.received()[0].GetSequence())
+	require.NotNil(t, v2.received()[1].GetMail().GetSendCompleted())
+	require.NotNil(t, v2.received()[3].GetUser().GetAccountAutoDisabledEvent())
+	require.NotNil(t, v2.received()[4].GetApp().GetStreamEnding())
+
+	// A v1 client only receives the events it knows, without the fields it doesn't.
+	require.Len(t, v1.received(), 2)
+	require.NotNil(t, v1.received()[0].GetApp().GetShowMainWindow())
+	require.Empty(t, v1.received()[0].GetMetadata())
+	require.Zero(t, v1.received()[0].GetSequence())
+	require.NotNil(t, v1.received()[1].GetUser().GetUserChanged())
+	require.Zero(t, v1.received()[1].GetSequence())
+}
+
+func TestService_EventStreamUnknownProtocolVersion(t *testing.T) {
+	s := newTestService()
+
+	for _, version := range []uint32{0, 42} {
+		server := newFakeEventStreamServer()
+		errCh := startTestEventStreamWithRequest(t, s, server, &EventStreamRequest{
+			ClientPlatform:  "test",
+			ProtocolVersion: version,
+		})
+
+		require.NoError(t, s.SendEvent(NewMailMessageTruncatedEvent("userID", "messageID", "reason", 1)))
+		require.NoError(t, s.SendEvent(NewMailAddressChangeEvent("a@b.c")))
+
+		// Clients of unknown versions get the legacy protocol.
+		require.Eventually(t, func() bool { return len(server.received()) == 1 }, time.Second, time.Millisecond)
+		require.NotNil(t, server.received()[0].GetMail().GetAddressChanged())
+		require.Zero(t, server.received()[0].GetSequence())
+
+		require.NoError(t, s.stopEventStream(streamEndingReasonStopped))
+		require.NoError(t, <-errCh)
+		require.Len(t, server.received(), 1)
+	}
+}
+
+func TestNegotiateEventProtocol(t *testing.T) {
+	require.Equal(t, eventProtocolV1, negotiateEventProtocol(0))
+	require.Equal(t, eventProtocolV1, negotiateEventProtocol(1))
+	require.Equal(t, eventProtocolV2, negotiateEventProtocol(2))
+	require.Equal(t, eventProtocolV1, negotiateEventProtocol(3))
+}
+
+// startTestEventStreamFrom is like startTestEventStreamWithServer, but the events sent after the given sequence are
+// replayed first.
+func startTestEventStreamFrom(t *testing.T, s *Service, server *fakeEventStreamServer, lastSeenSequence uint64) <-chan error {
+	return startTestEventStreamWithRequest(t, s, server, &EventStreamRequest{
+		ClientPlatform:   "test",
+		LastSeenSequence: lastSeenSequence,
+		ProtocolVersion:  eventProtocolLatest,
+	})
+}
+
+// startTestEventStreamWithRequest starts an event stream for the given request and waits for it to be running.
+func startTestEventStreamWithRequest(t *testing.T, s *Service, server *fakeEventStreamServer, request *EventStreamRequest) <-chan error {
+	errCh := make(chan error, 1)
+
+	go func() { errCh <- s.runEventStream(request, server) }()
+
+	require.Eventually(t, s.isStreamingEvents, time.Second, time.Millisecond)
+
+	return errCh
+}
+
+func TestService_StartEventTestCategory(t *testing.T) {
+	s := newTestService()
+
+	require.NoError(t, s.StartEventTestCategory(EventCategory_KEYCHAIN_EVENTS))
+
+	// Only the keychain events are sent, and they are all sent.
+	queued := s.eventQueue
+	require.Len(t, queued, 3)
+
+	for _, event := range queued {
+		require.Equal(t, EventCategory_KEYCHAIN_EVENTS, event.Category())
+	}
+
+	// All the events but the stream control ones are sent by the full test.
+	s = newTestService()
+
+	require.NoError(t, s.StartEventTest())
+	require.Len(t, s.eventQueue, len(xslices.Filter(newTestEvents(), func(event *StreamEvent) bool {
+		return !event.isStreamControl()
+	})))
+
+	for _, event := range s.eventQueue {
+		require.False(t, event.isStreamControl(), event.Kind())
+	}
+
+	// Nor by the test of their category.
+	s = newTestService()
+
+	require.NoError(t, s.StartEventTestCategory(EventCategory_APP_EVENTS))
+	require.NotEmpty(t, s.eventQueue)
+
+	for _, event := range s.eventQueue {
+		require.False(t, event.isStreamControl(), event.Kind())
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+)
+
+const (
+	webSocketEventsPath      = "/events"   // The path of the WebSocket event endpoint.
+	webSocketTokenQueryParam = "token"     // Browsers cannot set headers on WebSocket requests, so the token may be passed in the query.
+	webSocketFormatParam     = "format"    // The query parameter selecting the serialization format of the events, JSON by default.
+	webSocketClientPlatform  = "websocket" // The client platform reported for WebSocket event streams.
+	webSocketHeaderTimeout   = time.Second * 5
+)
+
+// newWebSocketListener returns a listener for the WebSocket event endpoint, bound to localhost only.
+func newWebSocketListener() (net.Listener, int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0") // Port should be provided by the OS.
+	if err != nil {
+		return nil, 0, err
+	}
+
+	address, ok := listener.Addr().(*net.TCPAddr)
+	if !ok {
+		_ = listener.Close()
+		return nil, 0, errors.New("could not retrieve WebSocket listener address")
+	}
+
+	return listener, address.Port, nil
+}
+
+// newWebSocketServer returns an HTTP server mirroring the gRPC event stream as WebSocket frames.
+func (s *Service) newWebSocketServer(token string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(webSocketEventsPath, s.newWebSocketHandler(token))
+
+	return &http.Server{Handler: mux, ReadHeaderTimeout: webSocketHeaderTimeout}
+}
+
+// newWebSocketHandler returns the handler of the WebSocket event endpoint. Clients must provide the same server token
+// as gRPC clients, either in the server-token header or in the token query parameter. They may select the serialization
+// format of the events in the format query parameter; events are sent as text frames in JSON, as binary frames otherwise.
+func (s *Service) newWebSocketHandler(token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotToken := r.Header.Get(serverTokenMetadataKey)
+		if gotToken == "" {
+			gotToken = r.URL.Query().Get(webSocketTokenQueryParam)
+		}
+
+		if subtle.ConstantTimeCompare([]byte(gotToken), []byte(token)) != 1 {
+			http.Error(w, "invalid server token", http.StatusUnauthorized)
+			return
+		}
+
+		serializer, err := NewEventSerializer(r.URL.Query().Get(webSocketFormatParam))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		websocket.Server{
+			// The endpoint is protected by the server token, so requests from any origin are accepted, including
+			// requests without origin made by scripts.
+			Handshake: func(*websocket.Config, *http.Request) error { return nil },
+			Handler:   func(conn *websocket.Conn) { s.runWebSocketEventStream(conn, serializer) },
+		}.ServeHTTP(w, r)
+	})
+}
+
+// runWebSocketEventStream forwards events to the given WebSocket until the stream is stopped or the socket is closed.
+// Unlike the gRPC client, WebSocket clients are not the GUI, so closing the socket does not quit bridge.
+func (s *Service) runWebSocketEventStream(conn *websocket.Conn, serializer EventSerializer) {
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Clients are not expected to send anything; reading only lets us notice when the socket is closed.
+	go func() {
+		defer async.HandlePanic(s.panicHandler)
+		defer cancel()
+
+		for {
+			var frame []byte
+
+			if err := websocket.Message.Receive(conn, &frame); err != nil {
+				return
+			}
+		}
+	}()
+
+	if err := s.streamEvents(&EventStreamRequest{ClientPlatform: webSocketClientPlatform, ProtocolVersion: eventProtocolLatest}, &webSocketEventStream{ctx: ctx, conn: conn, serializer: serializer}); err != nil {
+		if !errors.Is(err, errStreamClosedByClient) {
+			s.log.WithError(err).Warn("WebSocket event stream failed")
+		}
+	}
+}
+
+// serveWebSocketEvents serves the WebSocket event endpoint, if enabled, until stopWebSocketEvents is called.
+func (s *Service) serveWebSocketEvents() {
+	if s.webSocketServer == nil {
+		return
+	}
+
+	go func() {
+		defer async.HandlePanic(s.panicHandler)
+
+		s.log.WithField("address", s.webSocketListener.Addr()).Info("Starting WebSocket event server")
+
+		if err := s.webSocketServer.Serve(s.webSocketListener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.WithError(err).Error("Failed to serve WebSocket events")
+		}
+	}()
+}
+
+func (s *Service) stopWebSocketEvents() {
+	if s.webSocketServer == nil {
+		return
+	}
+
+	if err := s.webSocketServer.Close(); err != nil {
+		s.log.WithError(err).Warn("Failed to stop WebSocket event server")
+	}
+}
+
+// webSocketEventStream is a Bridge_RunEventStreamServer sending serialized events as frames on a WebSocket.
+type webSocketEventStream struct {
+	grpc.ServerStream
+
+	ctx        context.Context
+	conn       *websocket.Conn
+	serializer EventSerializer
+}
+
+func (w *webSocketEventStream) Context() context.Context {
+	return w.ctx
+}
+
+func (w *webSocketEventStream) Send(event *StreamEvent) error {
+	b, err := w.serializer.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	// The websocket package sends byte slices as binary frames, and strings as text frames.
+	if w.serializer.IsBinary() {
+		return websocket.Message.Send(w.conn, b)
+	}
+
+	return websocket.Message.Send(w.conn, string(b))
+}
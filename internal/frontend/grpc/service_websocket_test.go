@@ -0,0 +1,147 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func dialTestWebSocket(srv *httptest.Server, token string) (*websocket.Conn, error) {
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + webSocketEventsPath + "?" + webSocketTokenQueryParam + "=" + token
+
+	return websocket.Dial(url, "", srv.URL)
+}
+
+func TestService_WebSocketEventStream(t *testing.T) {
+	s := newTestService()
+
+	srv := httptest.NewServer(s.newWebSocketServer("token").Handler)
+	defer srv.Close()
+
+	// The server token is required.
+	_, err := dialTestWebSocket(srv, "wrong")
+	require.Error(t, err)
+
+	conn, err := dialTestWebSocket(srv, "token")
+	require.NoError(t, err)
+	require.Eventually(t, s.isStreamingEvents, time.Second, time.Millisecond)
+
+	// Other clients may stream events at the same time.
+	other, err := dialTestWebSocket(srv, "token")
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return len(s.getActiveStreams()) == 2 }, time.Second, time.Millisecond)
+
+	sent := []*StreamEvent{
+		NewShowMainWindowEvent(),
+		NewUserChangedEvent("userID"),
+		NewEventsDroppedEvent(3),
+	}
+
+	go func() {
+		for _, event := range sent {
+			require.NoError(t, s.SendEvent(event))
+		}
+	}()
+
+	// Events are received in order by every client, as JSON text frames.
+	for _, event := range sent {
+		for _, conn := range []*websocket.Conn{conn, other} {
+			var frame string
+			require.NoError(t, websocket.Message.Receive(conn, &frame))
+
+			var received StreamEvent
+			require.NoError(t, protojson.Unmarshal([]byte(frame), &received))
+			require.True(t, proto.Equal(event, &received), "expected %v, got %v", event, &received)
+		}
+	}
+
+	// Closing the sockets ends the streams, without quitting bridge.
+	require.NoError(t, other.Close())
+	require.NoError(t, conn.Close())
+	require.Eventually(t, func() bool { return !s.isStreamingEvents() }, time.Second, time.Millisecond)
+
+	// Another client can then connect.
+	conn, err = dialTestWebSocket(srv, "token")
+	require.NoError(t, err)
+	require.Eventually(t, s.isStreamingEvents, time.Second, time.Millisecond)
+	require.NoError(t, conn.Close())
+}
+
+func TestService_WebSocketEventStream_HeaderToken(t *testing.T) {
+	s := newTestService()
+
+	srv := httptest.NewServer(s.newWebSocketServer("token").Handler)
+	defer srv.Close()
+
+	// Requests without a token, or which are not WebSocket requests, are rejected.
+	res, err := http.Get(srv.URL + webSocketEventsPath)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	config, err := websocket.NewConfig("ws"+strings.TrimPrefix(srv.URL, "http")+webSocketEventsPath, srv.URL)
+	require.NoError(t, err)
+
+	config.Header.Set(serverTokenMetadataKey, "token")
+
+	conn, err := websocket.DialConfig(config)
+	require.NoError(t, err)
+	require.Eventually(t, s.isStreamingEvents, time.Second, time.Millisecond)
+	require.NoError(t, conn.Close())
+	require.Eventually(t, func() bool { return !s.isStreamingEvents() }, time.Second, time.Millisecond)
+}
+
+func TestService_WebSocketEventStream_Format(t *testing.T) {
+	s := newTestService()
+
+	srv := httptest.NewServer(s.newWebSocketServer("token").Handler)
+	defer srv.Close()
+
+	// Unknown formats are rejected.
+	res, err := http.Get(srv.URL + webSocketEventsPath + "?" + webSocketTokenQueryParam + "=token&" + webSocketFormatParam + "=xml")
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	// Binary formats are sent as binary frames.
+	conn, err := dialTestWebSocket(srv, "token&"+webSocketFormatParam+"="+EventFormatMsgpack)
+	require.NoError(t, err)
+	require.Eventually(t, s.isStreamingEvents, time.Second, time.Millisecond)
+
+	event := NewUserChangedEvent("userID")
+	require.NoError(t, s.SendEvent(event))
+
+	var frame []byte
+	require.NoError(t, websocket.Message.Receive(conn, &frame))
+
+	var received StreamEvent
+	require.NoError(t, msgpackEventSerializer{}.Unmarshal(frame, &received))
+	require.True(t, proto.Equal(event, &received), "expected %v, got %v", event, &received)
+
+	require.NoError(t, conn.Close())
+	require.Eventually(t, func() bool { return !s.isStreamingEvents() }, time.Second, time.Millisecond)
+}
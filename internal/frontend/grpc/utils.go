@@ -22,8 +22,11 @@ import (
 	"strings"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/sendrecorder"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
 var (
@@ -73,6 +76,27 @@ func grpcUserFromInfo(user bridge.UserInfo) *User {
 	}
 }
 
+// grpcSendRecorderEntries converts the send recorder entries of each user to gRPC entries, sorted by user ID.
+func grpcSendRecorderEntries(state map[string][]sendrecorder.EntrySnapshot) []*SendRecorderEntry {
+	userIDs := maps.Keys(state)
+	slices.Sort(userIDs)
+
+	var entries []*SendRecorderEntry
+
+	for _, userID := range userIDs {
+		for _, entry := range state[userID] {
+			entries = append(entries, &SendRecorderEntry{
+				UserID:       userID,
+				Hash:         entry.Hash,
+				HasMessageID: entry.HasMessageID,
+				ExpiresInMs:  entry.ExpiresIn.Milliseconds(),
+			})
+		}
+	}
+
+	return entries
+}
+
 func userStateToGrpc(state bridge.UserState) UserState {
 	switch state {
 	case bridge.SignedOut:
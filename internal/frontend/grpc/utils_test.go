@@ -0,0 +1,55 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/sendrecorder"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrpcSendRecorderEntries(t *testing.T) {
+	entries := grpcSendRecorderEntries(map[string][]sendrecorder.EntrySnapshot{
+		"user2": {{Hash: "hash3", HasMessageID: false, ExpiresIn: 90 * time.Second}},
+		"user1": {
+			{Hash: "hash1", HasMessageID: true, ExpiresIn: 1500 * time.Millisecond},
+			{Hash: "hash2", HasMessageID: false, ExpiresIn: time.Minute},
+		},
+		"user3": nil,
+	})
+
+	require.Len(t, entries, 3)
+
+	require.Equal(t, "user1", entries[0].GetUserID())
+	require.Equal(t, "hash1", entries[0].GetHash())
+	require.True(t, entries[0].GetHasMessageID())
+	require.Equal(t, int64(1500), entries[0].GetExpiresInMs())
+
+	require.Equal(t, "user1", entries[1].GetUserID())
+	require.Equal(t, "hash2", entries[1].GetHash())
+	require.False(t, entries[1].GetHasMessageID())
+	require.Equal(t, int64(60000), entries[1].GetExpiresInMs())
+
+	require.Equal(t, "user2", entries[2].GetUserID())
+	require.Equal(t, "hash3", entries[2].GetHash())
+	require.Equal(t, int64(90000), entries[2].GetExpiresInMs())
+
+	require.Empty(t, grpcSendRecorderEntries(nil))
+}
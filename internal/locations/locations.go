@@ -198,6 +198,16 @@ func (l *Locations) ProvideStatsPath() (string, error) {
 	return l.getStatsPath(), nil
 }
 
+// ProvideSendRecorderPath returns a location for the send recorder files of the users
+// (e.g. ~/.local/share/<company>/<app>/send-recorder). It creates it if it doesn't already exist.
+func (l *Locations) ProvideSendRecorderPath() (string, error) {
+	if err := os.MkdirAll(l.getSendRecorderPath(), 0o700); err != nil {
+		return "", err
+	}
+
+	return l.getSendRecorderPath(), nil
+}
+
 func (l *Locations) ProvideIMAPSyncConfigPath() (string, error) {
 	if err := os.MkdirAll(l.getIMAPSyncConfigPath(), 0o700); err != nil {
 		return "", err
@@ -242,6 +252,10 @@ func (l *Locations) getStatsPath() string {
 	return filepath.Join(l.userData, "stats")
 }
 
+func (l *Locations) getSendRecorderPath() string {
+	return filepath.Join(l.userData, "send-recorder")
+}
+
 // Clear removes everything except the lock and update files.
 func (l *Locations) Clear(except ...string) error {
 	return files.Remove(
@@ -25,6 +25,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sync"
 	"time"
 
 	"github.com/bradenaw/juniper/xslices"
@@ -51,6 +52,20 @@ const (
 	GUIShortAppName      AppName = "gui"
 )
 
+var (
+	// outputLock guards the output, hooks and formatter of the standard logger, which change along with the level.
+	outputLock sync.Mutex //nolint:gochecknoglobals
+
+	// beforeTrace is how logs were written before the level was set to trace, restored when leaving it.
+	beforeTrace *loggerOutput //nolint:gochecknoglobals
+)
+
+type loggerOutput struct {
+	out       io.Writer
+	hooks     logrus.LevelHooks
+	formatter logrus.Formatter
+}
+
 type coloredStdOutHook struct {
 	formatter logrus.Formatter
 }
@@ -90,6 +105,11 @@ func (cs *coloredStdOutHook) Fire(entry *logrus.Entry) error {
 // Init Initialize logging. Log files are rotated when their size exceeds rotationSize. if pruningSize >= 0, pruning occurs using
 // the default pruning algorithm.
 func Init(logsPath string, sessionID SessionID, appName AppName, rotationSize, pruningSize int64, level string) (io.Closer, error) {
+	outputLock.Lock()
+	defer outputLock.Unlock()
+
+	beforeTrace = nil
+
 	logrus.SetFormatter(&logrus.TextFormatter{
 		DisableColors:   true,
 		FullTimestamp:   true,
@@ -105,7 +125,7 @@ func Init(logsPath string, sessionID SessionID, appName AppName, rotationSize, p
 
 	logrus.SetOutput(rotator)
 
-	return rotator, setLevel(level)
+	return rotator, setLevelUnsafe(level)
 }
 
 // Close closes the log file. if closer is nil, no error is reported.
@@ -114,7 +134,13 @@ func Close(closer io.Closer) error {
 		return nil
 	}
 
+	outputLock.Lock()
 	logrus.SetOutput(os.Stdout)
+	if beforeTrace != nil {
+		beforeTrace.out = os.Stdout
+	}
+	outputLock.Unlock()
+
 	return closer.Close()
 }
 
@@ -174,11 +200,32 @@ func getOrderedLogFileListForBugReport(logsPath string, maxSessionCount int) ([]
 	return result, nil
 }
 
-// setLevel will change the level of logging and in case of Debug or Trace
-// level it will also prevent from writing to file. Setting level to Info or
-// higher will not set writing to file again if it was previously cancelled by
-// Debug or Trace.
-func setLevel(level string) error {
+// SetLevel changes the level of logging at runtime. See setLevelUnsafe.
+func SetLevel(level string) error {
+	outputLock.Lock()
+	defer outputLock.Unlock()
+
+	return setLevelUnsafe(level)
+}
+
+// CurrentLogFilePath returns the path of the file logs are currently written to, or an empty string if logs are not
+// written to a file, e.g. because logging was not initialized or the level is trace.
+func CurrentLogFilePath() string {
+	outputLock.Lock()
+	defer outputLock.Unlock()
+
+	if rotator, ok := logrus.StandardLogger().Out.(*Rotator); ok {
+		return rotator.FilePath()
+	}
+
+	return ""
+}
+
+// setLevelUnsafe will change the level of logging and in case of Trace level
+// it will also write logs to stderr rather than to file. Leaving Trace level
+// restores the output, hooks and formatter used before.
+// The caller must hold outputLock.
+func setLevelUnsafe(level string) error {
 	if level == "" {
 		level = "debug"
 	}
@@ -190,15 +237,30 @@ func setLevel(level string) error {
 
 	logrus.SetLevel(logLevel)
 
-	// The hook to print panic, fatal and error to stderr is always
-	// added. We want to avoid log duplicates by replacing all hooks.
-	if logrus.GetLevel() == logrus.TraceLevel {
-		_ = logrus.StandardLogger().ReplaceHooks(logrus.LevelHooks{})
+	logger := logrus.StandardLogger()
+
+	switch {
+	case logLevel == logrus.TraceLevel && beforeTrace == nil:
+		// The hook to print panic, fatal and error to stderr is always
+		// added. We want to avoid log duplicates by replacing all hooks.
+		beforeTrace = &loggerOutput{
+			out:       logger.Out,
+			hooks:     logger.ReplaceHooks(logrus.LevelHooks{}),
+			formatter: logger.Formatter,
+		}
+
 		logrus.SetOutput(os.Stderr)
 		logrus.SetFormatter(&logrus.TextFormatter{
 			FullTimestamp:   true,
 			TimestampFormat: time.StampMilli,
 		})
+
+	case logLevel != logrus.TraceLevel && beforeTrace != nil:
+		_ = logger.ReplaceHooks(beforeTrace.hooks)
+		logrus.SetOutput(beforeTrace.out)
+		logrus.SetFormatter(beforeTrace.formatter)
+
+		beforeTrace = nil
 	}
 
 	return nil
@@ -103,3 +103,29 @@ func TestLogging_Close(t *testing.T) {
 	require.NotNil(t, closer)
 	require.NoError(t, closer.Close())
 }
+
+func TestLogging_SetLevel_Trace(t *testing.T) {
+	closer, err := Init(t.TempDir(), NewSessionID(), constants.AppName, DefaultMaxLogFileSize, DefaultPruningSize, "debug")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, Close(closer)) }()
+
+	filePath := CurrentLogFilePath()
+	require.NotEmpty(t, filePath)
+
+	hooks := logrus.StandardLogger().Hooks[logrus.ErrorLevel]
+	require.NotEmpty(t, hooks)
+
+	// Trace logs are written to stderr only.
+	require.NoError(t, SetLevel("trace"))
+	require.Empty(t, CurrentLogFilePath())
+	require.Empty(t, logrus.StandardLogger().Hooks)
+
+	// Leaving trace writes logs to the file again.
+	require.NoError(t, SetLevel("info"))
+	require.Equal(t, logrus.InfoLevel, logrus.GetLevel())
+	require.Equal(t, filePath, CurrentLogFilePath())
+	require.Equal(t, hooks, logrus.StandardLogger().Hooks[logrus.ErrorLevel])
+
+	require.NoError(t, SetLevel("debug"))
+	require.Equal(t, filePath, CurrentLogFilePath())
+}
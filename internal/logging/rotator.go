@@ -22,6 +22,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 )
@@ -33,6 +34,10 @@ type Rotator struct {
 	size        int64
 	maxFileSize int64
 	nextIndex   int
+
+	// path is the path of the current log file, if it is a file.
+	path     string
+	pathLock sync.Mutex
 }
 
 type FileProvider func(index int) (io.WriteCloser, error)
@@ -112,5 +117,22 @@ func (r *Rotator) rotate() error {
 	r.wc = wc
 	r.size = 0
 
+	r.pathLock.Lock()
+	defer r.pathLock.Unlock()
+
+	if file, ok := wc.(interface{ Name() string }); ok {
+		r.path = file.Name()
+	} else {
+		r.path = ""
+	}
+
 	return nil
 }
+
+// FilePath returns the path of the log file currently written to, or an empty string if it is not a file.
+func (r *Rotator) FilePath() string {
+	r.pathLock.Lock()
+	defer r.pathLock.Unlock()
+
+	return r.path
+}
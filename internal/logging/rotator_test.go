@@ -209,3 +209,30 @@ func cleanupLogs(t *testing.T, sessionID SessionID) {
 		require.NoError(t, os.Remove(path))
 	}
 }
+
+func TestLogging_RotatorFilePath(t *testing.T) {
+	dir := t.TempDir()
+
+	r, err := NewDefaultRotator(dir, NewSessionID(), BridgeShortAppName, 10, -1)
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, r.Close()) }()
+
+	first := r.FilePath()
+	require.Equal(t, dir, filepath.Dir(first))
+	require.FileExists(t, first)
+
+	// The path follows the rotation.
+	_, err = r.Write([]byte("0123456789"))
+	require.NoError(t, err)
+	_, err = r.Write([]byte("0"))
+	require.NoError(t, err)
+
+	require.NotEqual(t, first, r.FilePath())
+	require.FileExists(t, r.FilePath())
+
+	// Writers which are not files have no path.
+	buffered, err := NewRotator(10, func(int) (io.WriteCloser, error) { return &WriteCloser{}, nil }, nullPruner)
+	require.NoError(t, err)
+	require.Empty(t, buffered.FilePath())
+}
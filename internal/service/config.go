@@ -29,6 +29,7 @@ type Config struct {
 	Cert           string `json:"cert"`
 	Token          string `json:"token"`
 	FileSocketPath string `json:"fileSocketPath"`
+	WebSocketPort  int    `json:"webSocketPort,omitempty"` // The port of the WebSocket event endpoint, if enabled.
 }
 
 // save saves a gRPC service configuration to file.
@@ -32,6 +32,7 @@ import (
 	"github.com/ProtonMail/gluon/rfc822"
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/sendrecorder"
 	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/message"
@@ -62,8 +63,10 @@ type Connector struct {
 	updateCh    *async.QueuedChannel[imap.Update]
 	log         *logrus.Entry
 
-	sharedCache *SharedCache
-	syncState   *SyncState
+	sharedCache    *SharedCache
+	syncState      *SyncState
+	uploadReporter *uploadReporter
+	eventPublisher events.EventPublisher
 }
 
 func NewConnector(
@@ -77,6 +80,8 @@ func NewConnector(
 	telemetry Telemetry,
 	showAllMail bool,
 	syncState *SyncState,
+	uploadReporter *uploadReporter,
+	eventPublisher events.EventPublisher,
 ) *Connector {
 	userID := identityState.UserID()
 
@@ -107,8 +112,10 @@ func NewConnector(
 			"user-id":         userID,
 		}),
 
-		sharedCache: NewSharedCached(),
-		syncState:   syncState,
+		sharedCache:    NewSharedCached(),
+		syncState:      syncState,
+		uploadReporter: uploadReporter,
+		eventPublisher: eventPublisher,
 	}
 }
 
@@ -193,25 +200,53 @@ func (s *Connector) CreateMailbox(ctx context.Context, _ connector.IMAPStateWrit
 	}
 }
 
+// GetMessageLiteral is only called by gluon when the cached literal of the message could not be read, in which case
+// the message is fetched again from the server and the corrupt cache entry is reported.
 func (s *Connector) GetMessageLiteral(ctx context.Context, id imap.MessageID) ([]byte, error) {
+	literal, err := s.fetchMessageLiteral(ctx, id)
+
+	if err != nil {
+		s.log.WithError(err).WithField("messageID", id).Error("Failed to fetch message with corrupt cache entry")
+	} else {
+		s.log.WithField("messageID", id).Warn("Recovered corrupt cache entry by fetching message again")
+	}
+
+	s.eventPublisher.PublishEvent(ctx, events.CorruptCacheEntry{
+		UserID:    s.identityState.UserID(),
+		MessageID: string(id),
+		Recovered: err == nil,
+	})
+
+	return literal, err
+}
+
+func (s *Connector) fetchMessageLiteral(ctx context.Context, id imap.MessageID) ([]byte, error) {
 	msg, err := s.client.GetFullMessage(ctx, string(id), usertypes.NewProtonAPIScheduler(s.panicHandler), proton.NewDefaultAttachmentAllocator())
 	if err != nil {
 		return nil, err
 	}
 
-	var literal []byte
-	err = s.identityState.WithAddrKR(msg.AddressID, func(_, addrKR *crypto.KeyRing) error {
-		l, buildErr := message.DecryptAndBuildRFC822(addrKR, msg.Message, msg.AttData, defaultMessageJobOpts())
+	var (
+		literal   bytes.Buffer
+		truncated string
+	)
+
+	if err := s.identityState.WithAddrKR(msg.AddressID, func(_, addrKR *crypto.KeyRing) error {
+		reason, buildErr := decryptAndBuildRFC822Into(addrKR, msg.Message, msg.AttData, &literal)
 		if buildErr != nil {
 			return buildErr
 		}
 
-		literal = l
+		truncated = reason
 
 		return nil
-	})
+	}); err != nil {
+		return nil, err
+	}
 
-	return literal, err
+	publishMessageTruncated(ctx, s.eventPublisher, s.identityState.UserID(), string(id), truncated)
+
+	return literal.Bytes(), nil
 }
 
 func (s *Connector) GetMailboxVisibility(_ context.Context, mboxID imap.MailboxID) imap.MailboxVisibility {
@@ -264,18 +299,21 @@ func (s *Connector) CreateMessage(ctx context.Context, _ connector.IMAPStateWrit
 		return imap.Message{}, nil, connector.ErrOperationNotAllowed
 	}
 
+	s.uploadReporter.OnUploadStarted(ctx)
+	defer s.uploadReporter.OnUploadDone(ctx)
+
 	toList, err := getLiteralToList(literal)
 	if err != nil {
 		return imap.Message{}, nil, fmt.Errorf("failed to retrieve addresses from literal:%w", err)
 	}
 
 	// Compute the hash of the message (to match it against SMTP messages).
-	hash, err := sendrecorder.GetMessageHash(literal)
+	hash, err := s.sendRecorder.HashMessage(literal)
 	if err != nil {
 		return imap.Message{}, nil, err
 	}
 
-	// Check if we already tried to send this message recently.
+	// Check if we already tried to send this message recently (messages which could not be hashed were never recorded).
 	if messageID, ok, err := s.sendRecorder.HasEntryWait(ctx, hash, time.Now().Add(90*time.Second), toList); err != nil {
 		return imap.Message{}, nil, fmt.Errorf("failed to check send hash: %w", err)
 	} else if ok {
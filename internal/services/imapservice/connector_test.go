@@ -19,11 +19,19 @@ package imapservice
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/gluon/imap"
+	"github.com/ProtonMail/gluon/rfc822"
 	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice/mocks"
+	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
 	"github.com/golang/mock/gomock"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
@@ -203,3 +211,155 @@ func TestFixGODT3003Labels_Noop(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, applied)
 }
+
+type eventRecorder struct {
+	lock   sync.Mutex
+	events []events.Event
+}
+
+func (r *eventRecorder) PublishEvent(_ context.Context, event events.Event) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.events = append(r.events, event)
+}
+
+type literalAPIClient struct {
+	APIClient
+
+	msg proton.Message
+	err error
+}
+
+func (c *literalAPIClient) GetFullMessage(context.Context, string, proton.Scheduler, proton.AttachmentAllocator) (proton.FullMessage, error) {
+	if c.err != nil {
+		return proton.FullMessage{}, c.err
+	}
+
+	return proton.FullMessage{Message: c.msg}, nil
+}
+
+type literalIdentity struct {
+	sharedIdentity
+
+	kr *crypto.KeyRing
+}
+
+func (id *literalIdentity) UserID() string {
+	return "userID"
+}
+
+func (id *literalIdentity) WithAddrKR(_ string, fn func(userKR, addrKR *crypto.KeyRing) error) error {
+	return fn(id.kr, id.kr)
+}
+
+func newLiteralTestConnector(t *testing.T, client APIClient, publisher events.EventPublisher) *Connector {
+	key, err := crypto.GenerateKey("name", "name@example.com", "x25519", 0)
+	require.NoError(t, err)
+
+	kr, err := crypto.NewKeyRing(key)
+	require.NoError(t, err)
+
+	if client, ok := client.(*literalAPIClient); ok && client.err == nil {
+		enc, err := kr.Encrypt(crypto.NewPlainMessageFromString("body"), kr)
+		require.NoError(t, err)
+
+		arm, err := enc.GetArmored()
+		require.NoError(t, err)
+
+		client.msg = proton.Message{
+			MessageMetadata: proton.MessageMetadata{ID: "messageID", AddressID: "addrID", Time: time.Now().Unix()},
+			ParsedHeaders: proton.Headers{
+				Values: map[string][]string{"Content-Type": {"text/plain"}},
+				Order:  []string{"Content-Type"},
+			},
+			MIMEType: rfc822.TextPlain,
+			Body:     arm,
+		}
+	}
+
+	connector := NewConnector(
+		"addrID",
+		client,
+		newRWLabels(),
+		&literalIdentity{kr: kr},
+		usertypes.AddressModeCombined,
+		nil,
+		&async.NoopPanicHandler{},
+		nil,
+		false,
+		nil,
+		nil,
+		publisher,
+	)
+	t.Cleanup(connector.StateClose)
+
+	return connector
+}
+
+func TestGetMessageLiteral_CorruptCacheEntryRecovered(t *testing.T) {
+	publisher := &eventRecorder{}
+	connector := newLiteralTestConnector(t, &literalAPIClient{}, publisher)
+
+	// Gluon only asks the connector for the literal when it could not be read from the cache.
+	literal, err := connector.GetMessageLiteral(context.Background(), "messageID")
+	require.NoError(t, err)
+	require.Contains(t, string(literal), "body")
+
+	require.Equal(t, []events.Event{events.CorruptCacheEntry{
+		UserID:    "userID",
+		MessageID: "messageID",
+		Recovered: true,
+	}}, publisher.events)
+}
+
+func TestGetMessageLiteral_Truncated(t *testing.T) {
+	publisher := &eventRecorder{}
+	client := &literalAPIClient{}
+	connector := newLiteralTestConnector(t, client, publisher)
+
+	// The body is encrypted with another key, so it cannot be decrypted and is served encrypted.
+	key, err := crypto.GenerateKey("other", "other@example.com", "x25519", 0)
+	require.NoError(t, err)
+
+	kr, err := crypto.NewKeyRing(key)
+	require.NoError(t, err)
+
+	enc, err := kr.Encrypt(crypto.NewPlainMessageFromString("body"), nil)
+	require.NoError(t, err)
+
+	client.msg.Body, err = enc.GetArmored()
+	require.NoError(t, err)
+
+	literal, err := connector.GetMessageLiteral(context.Background(), "messageID")
+	require.NoError(t, err)
+	require.NotContains(t, string(literal), "\r\nbody")
+
+	require.Equal(t, []events.Event{
+		events.MessageTruncated{
+			UserID:    "userID",
+			MessageID: "messageID",
+			Reason:    "body could not be decrypted",
+			Count:     1,
+		},
+		events.CorruptCacheEntry{
+			UserID:    "userID",
+			MessageID: "messageID",
+			Recovered: true,
+		},
+	}, publisher.events)
+}
+
+func TestGetMessageLiteral_CorruptCacheEntryNotRecovered(t *testing.T) {
+	publisher := &eventRecorder{}
+	connector := newLiteralTestConnector(t, &literalAPIClient{err: errors.New("unavailable")}, publisher)
+
+	_, err := connector.GetMessageLiteral(context.Background(), "messageID")
+	require.Error(t, err)
+
+	require.Equal(t, []events.Event{events.CorruptCacheEntry{
+		UserID:    "userID",
+		MessageID: "messageID",
+		Recovered: false,
+	}}, publisher.events)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+)
+
+// messageEventsReporter reports the progress of the batches of message events being handled, which can take a while
+// when catching up with many changes. Progress is reported at most once per freq, and only once the batch has been
+// handled for longer than freq, so that small batches do not produce any event.
+type messageEventsReporter struct {
+	userID         string
+	eventPublisher events.EventPublisher
+	freq           time.Duration
+
+	start    time.Time
+	last     time.Time
+	reported bool
+}
+
+func newMessageEventsReporter(userID string, eventPublisher events.EventPublisher, freq time.Duration) *messageEventsReporter {
+	return &messageEventsReporter{
+		userID:         userID,
+		eventPublisher: eventPublisher,
+		freq:           freq,
+	}
+}
+
+// OnBatchStarted should be called before handling a batch of message events.
+func (rep *messageEventsReporter) OnBatchStarted() {
+	rep.start = time.Now()
+	rep.last = rep.start
+	rep.reported = false
+}
+
+// OnProgress should be called with the number of events of the batch handled so far.
+func (rep *messageEventsReporter) OnProgress(ctx context.Context, processed, total int) {
+	if total == 0 {
+		return
+	}
+
+	// Always report the end of the batch if its progress was reported.
+	if !(processed == total && rep.reported) && time.Since(rep.last) < rep.freq {
+		return
+	}
+
+	var remaining time.Duration
+
+	if processed > 0 {
+		remaining = time.Since(rep.start) * time.Duration(total-processed) / time.Duration(processed)
+	}
+
+	rep.eventPublisher.PublishEvent(ctx, events.SyncProgress{
+		UserID:    rep.userID,
+		Progress:  float64(processed) / float64(total),
+		Elapsed:   time.Since(rep.start),
+		Remaining: remaining,
+		Processed: int64(processed),
+		Total:     int64(total),
+	})
+
+	rep.last = time.Now()
+	rep.reported = true
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapservice
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageEventsReporter_HandleMessageEvents(t *testing.T) {
+	publisher := &eventRecorder{}
+
+	s := &Service{
+		log:                   logrus.WithField("pkg", "imapservice/test"),
+		scheduledSends:        newScheduledSends("userID", publisher),
+		messageEventsReporter: newMessageEventsReporter("userID", publisher, 0),
+	}
+
+	const total = 5
+
+	messageEvents := make([]proton.MessageEvent, 0, total)
+
+	for i := 0; i < total; i++ {
+		messageEvents = append(messageEvents, proton.MessageEvent{
+			EventItem: proton.EventItem{ID: fmt.Sprint(i), Action: proton.EventDelete},
+		})
+	}
+
+	require.NoError(t, s.HandleMessageEvents(context.Background(), messageEvents))
+
+	// The progress is reported with increasing counts, up to the total.
+	require.Len(t, publisher.events, total+1)
+
+	for i, event := range publisher.events {
+		progress, ok := event.(events.SyncProgress)
+		require.True(t, ok)
+		require.Equal(t, "userID", progress.UserID)
+		require.Equal(t, int64(i), progress.Processed)
+		require.Equal(t, int64(total), progress.Total)
+		require.Equal(t, float64(i)/total, progress.Progress)
+	}
+}
+
+func TestMessageEventsReporter_RateLimited(t *testing.T) {
+	publisher := &eventRecorder{}
+	rep := newMessageEventsReporter("userID", publisher, time.Hour)
+
+	ctx := context.Background()
+
+	// Batches handled faster than the report frequency are not reported.
+	rep.OnBatchStarted()
+
+	for i := 0; i <= 10; i++ {
+		rep.OnProgress(ctx, i, 10)
+	}
+
+	require.Empty(t, publisher.events)
+
+	// Once reported, the end of the batch is always reported.
+	rep.OnBatchStarted()
+	rep.last = time.Now().Add(-time.Hour)
+
+	for i := 0; i <= 10; i++ {
+		rep.OnProgress(ctx, i, 10)
+	}
+
+	require.Len(t, publisher.events, 2)
+	require.Equal(t, int64(0), publisher.events[0].(events.SyncProgress).Processed)  //nolint:forcetypeassert
+	require.Equal(t, int64(10), publisher.events[1].(events.SyncProgress).Processed) //nolint:forcetypeassert
+
+	// Empty batches are not reported.
+	rep.OnBatchStarted()
+	rep.OnProgress(ctx, 0, 0)
+	require.Len(t, publisher.events, 2)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapservice
+
+import (
+	"context"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"golang.org/x/exp/slices"
+)
+
+// scheduledSends keeps track of the messages scheduled to be sent later, to report when the server delivers them.
+// Only the messages seen scheduled in the events handled while bridge is running are tracked.
+type scheduledSends struct {
+	userID         string
+	eventPublisher events.EventPublisher
+	messageIDs     map[string]struct{}
+}
+
+func newScheduledSends(userID string, eventPublisher events.EventPublisher) *scheduledSends {
+	return &scheduledSends{
+		userID:         userID,
+		eventPublisher: eventPublisher,
+		messageIDs:     make(map[string]struct{}),
+	}
+}
+
+// onMessageEvent tracks the given message if it is scheduled, and reports its delivery once a tracked message left the
+// scheduled messages for the sent ones. A tracked message going anywhere else had its schedule cancelled.
+func (s *scheduledSends) onMessageEvent(ctx context.Context, event proton.MessageEvent) {
+	if event.Action == proton.EventDelete {
+		delete(s.messageIDs, event.ID)
+		return
+	}
+
+	if slices.Contains(event.Message.LabelIDs, proton.AllScheduledLabel) {
+		s.messageIDs[event.ID] = struct{}{}
+		return
+	}
+
+	if _, ok := s.messageIDs[event.ID]; !ok {
+		return
+	}
+
+	delete(s.messageIDs, event.ID)
+
+	if slices.Contains(event.Message.LabelIDs, proton.AllSentLabel) {
+		s.eventPublisher.PublishEvent(ctx, events.ScheduledSendDelivered{
+			UserID:    s.userID,
+			MessageID: event.ID,
+		})
+	}
+}
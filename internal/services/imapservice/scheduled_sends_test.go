@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/stretchr/testify/require"
+)
+
+func newScheduledMessageEvent(action proton.EventAction, messageID string, labelIDs ...string) proton.MessageEvent {
+	return proton.MessageEvent{
+		EventItem: proton.EventItem{ID: messageID, Action: action},
+		Message:   proton.MessageMetadata{ID: messageID, LabelIDs: labelIDs},
+	}
+}
+
+func TestScheduledSends_Delivered(t *testing.T) {
+	publisher := &eventRecorder{}
+	sends := newScheduledSends("userID", publisher)
+
+	ctx := context.Background()
+
+	sends.onMessageEvent(ctx, newScheduledMessageEvent(proton.EventCreate, "messageID", proton.AllScheduledLabel, proton.AllMailLabel))
+	require.Empty(t, publisher.events)
+
+	// The server sends the message when it is due, moving it from the scheduled to the sent messages.
+	sends.onMessageEvent(ctx, newScheduledMessageEvent(proton.EventUpdate, "messageID", proton.AllSentLabel, proton.SentLabel, proton.AllMailLabel))
+	require.Equal(t, []events.Event{events.ScheduledSendDelivered{UserID: "userID", MessageID: "messageID"}}, publisher.events)
+
+	// Later updates of the sent message are not reported again.
+	sends.onMessageEvent(ctx, newScheduledMessageEvent(proton.EventUpdateFlags, "messageID", proton.AllSentLabel, proton.SentLabel, proton.AllMailLabel))
+	require.Len(t, publisher.events, 1)
+}
+
+func TestScheduledSends_NotDelivered(t *testing.T) {
+	publisher := &eventRecorder{}
+	sends := newScheduledSends("userID", publisher)
+
+	ctx := context.Background()
+
+	// A message sent right away.
+	sends.onMessageEvent(ctx, newScheduledMessageEvent(proton.EventCreate, "sent", proton.AllSentLabel, proton.SentLabel))
+
+	// A scheduled message whose schedule was cancelled, going back to the drafts.
+	sends.onMessageEvent(ctx, newScheduledMessageEvent(proton.EventCreate, "cancelled", proton.AllScheduledLabel))
+	sends.onMessageEvent(ctx, newScheduledMessageEvent(proton.EventUpdate, "cancelled", proton.AllDraftsLabel, proton.DraftsLabel))
+
+	// A scheduled message which was deleted.
+	sends.onMessageEvent(ctx, newScheduledMessageEvent(proton.EventCreate, "deleted", proton.AllScheduledLabel))
+	sends.onMessageEvent(ctx, proton.MessageEvent{EventItem: proton.EventItem{ID: "deleted", Action: proton.EventDelete}})
+
+	require.Empty(t, publisher.events)
+	require.Empty(t, sends.messageIDs)
+}
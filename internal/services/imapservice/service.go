@@ -87,11 +87,15 @@ type Service struct {
 	maxSyncMemory     uint64
 	showAllMail       bool
 
-	syncHandler        *syncservice.Handler
-	syncUpdateApplier  *SyncUpdateApplier
-	syncMessageBuilder *SyncMessageBuilder
-	syncStateProvider  *SyncState
-	syncReporter       *syncReporter
+	syncHandler           *syncservice.Handler
+	syncUpdateApplier     *SyncUpdateApplier
+	syncMessageBuilder    *SyncMessageBuilder
+	syncStateProvider     *SyncState
+	syncReporter          *syncReporter
+	uploadReporter        *uploadReporter
+	scheduledSends        *scheduledSends
+	messageEventsReporter *messageEventsReporter
+	truncationReporter    *truncationReporter
 
 	syncConfigPath     string
 	lastHandledEventID string
@@ -126,7 +130,8 @@ func NewService(
 	rwIdentity := newRWIdentity(identityState, bridgePassProvider, keyPassProvider)
 
 	syncUpdateApplier := NewSyncUpdateApplier()
-	syncMessageBuilder := NewSyncMessageBuilder(rwIdentity)
+	truncationReporter := newTruncationReporter(identityState.User.ID, eventPublisher, panicHandler, 10*time.Second)
+	syncMessageBuilder := NewSyncMessageBuilder(rwIdentity, truncationReporter)
 	syncReporter := newSyncReporter(identityState.User.ID, eventPublisher, time.Second)
 
 	return &Service{
@@ -156,10 +161,14 @@ func NewService(
 		eventSubscription: subscription,
 		showAllMail:       showAllMail,
 
-		syncUpdateApplier:  syncUpdateApplier,
-		syncMessageBuilder: syncMessageBuilder,
-		syncReporter:       syncReporter,
-		syncConfigPath:     GetSyncConfigPath(syncConfigDir, identityState.User.ID),
+		syncUpdateApplier:     syncUpdateApplier,
+		syncMessageBuilder:    syncMessageBuilder,
+		syncReporter:          syncReporter,
+		uploadReporter:        newUploadReporter(identityState.User.ID, eventPublisher, time.Second),
+		scheduledSends:        newScheduledSends(identityState.User.ID, eventPublisher),
+		messageEventsReporter: newMessageEventsReporter(identityState.User.ID, eventPublisher, time.Second),
+		truncationReporter:    truncationReporter,
+		syncConfigPath:        GetSyncConfigPath(syncConfigDir, identityState.User.ID),
 	}
 }
 
@@ -256,6 +265,8 @@ func (s *Service) Close() {
 	}
 
 	s.connectors = make(map[string]*Connector)
+
+	s.truncationReporter.stop()
 }
 
 func (s *Service) HandleRefreshEvent(ctx context.Context, _ proton.RefreshFlag) error {
@@ -312,6 +323,9 @@ func (s *Service) run(ctx context.Context) { //nolint gocyclo
 		RefreshHandler: s,
 		LabelHandler:   s,
 		MessageHandler: s,
+
+		// Resume the message events of an event whose publish timed out rather than handle them all again.
+		MessageCheckpoint: &userevents.MessageCheckpoint{},
 	}
 
 	syncEventHandler := s.newSyncEventHandler()
@@ -505,6 +519,8 @@ func (s *Service) buildConnectors() (map[string]*Connector, error) {
 			s.telemetry,
 			s.showAllMail,
 			s.syncStateProvider,
+			s.uploadReporter,
+			s.eventPublisher,
 		)
 
 		return connectors, nil
@@ -522,6 +538,8 @@ func (s *Service) buildConnectors() (map[string]*Connector, error) {
 			s.telemetry,
 			s.showAllMail,
 			s.syncStateProvider,
+			s.uploadReporter,
+			s.eventPublisher,
 		)
 	}
 
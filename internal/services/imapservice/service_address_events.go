@@ -129,6 +129,8 @@ func addNewAddressSplitMode(ctx context.Context, s *Service, addrID string) erro
 		s.telemetry,
 		s.showAllMail,
 		s.syncStateProvider,
+		s.uploadReporter,
+		s.eventPublisher,
 	)
 
 	if err := s.serverManager.AddIMAPUser(ctx, connector, connector.addrID, s.gluonIDProvider, s.syncStateProvider); err != nil {
@@ -37,21 +37,34 @@ import (
 )
 
 func (s *Service) HandleMessageEvents(ctx context.Context, events []proton.MessageEvent) error {
+	_, err := s.HandlePartial(ctx, events)
+
+	return err
+}
+
+// HandlePartial handles the message events in order, returning how many were handled before any error.
+func (s *Service) HandlePartial(ctx context.Context, events []proton.MessageEvent) (int, error) {
 	s.log.Debug("handling message event")
 
-	for _, event := range events {
+	s.messageEventsReporter.OnBatchStarted()
+
+	for i, event := range events {
+		s.messageEventsReporter.OnProgress(ctx, i, len(events))
+
 		ctx = logging.WithLogrusField(ctx, "messageID", event.ID)
 
+		s.scheduledSends.onMessageEvent(ctx, event)
+
 		switch event.Action {
 		case proton.EventCreate:
 			updates, err := onMessageCreated(logging.WithLogrusField(ctx, "action", "create message"), s, event.Message, false)
 			if err != nil {
 				reportError(s.reporter, s.log, "Failed to apply create message event", err)
-				return fmt.Errorf("failed to handle create message event: %w", err)
+				return i, fmt.Errorf("failed to handle create message event: %w", err)
 			}
 
 			if err := waitOnIMAPUpdates(ctx, updates); err != nil {
-				return err
+				return i, err
 			}
 
 		case proton.EventUpdate, proton.EventUpdateFlags:
@@ -65,11 +78,11 @@ func (s *Service) HandleMessageEvents(ctx context.Context, events []proton.Messa
 				)
 				if err != nil {
 					reportError(s.reporter, s.log, "Failed to apply update draft message event", err)
-					return fmt.Errorf("failed to handle update draft event: %w", err)
+					return i, fmt.Errorf("failed to handle update draft event: %w", err)
 				}
 
 				if err := waitOnIMAPUpdates(ctx, updates); err != nil {
-					return err
+					return i, err
 				}
 
 				continue
@@ -86,7 +99,7 @@ func (s *Service) HandleMessageEvents(ctx context.Context, events []proton.Messa
 			)
 			if err != nil {
 				reportError(s.reporter, s.log, "Failed to apply update message event", err)
-				return fmt.Errorf("failed to handle update message event: %w", err)
+				return i, fmt.Errorf("failed to handle update message event: %w", err)
 			}
 
 			// If the update fails on the gluon side because it doesn't exist, we try to create the message instead.
@@ -95,14 +108,14 @@ func (s *Service) HandleMessageEvents(ctx context.Context, events []proton.Messa
 
 				updates, err := onMessageCreated(ctx, s, event.Message, false)
 				if err != nil {
-					return fmt.Errorf("failed to handle update message event as create: %w", err)
+					return i, fmt.Errorf("failed to handle update message event as create: %w", err)
 				}
 
 				if err := waitOnIMAPUpdates(ctx, updates); err != nil {
-					return err
+					return i, err
 				}
 			} else if err != nil {
-				return err
+				return i, err
 			}
 
 		case proton.EventDelete:
@@ -113,12 +126,14 @@ func (s *Service) HandleMessageEvents(ctx context.Context, events []proton.Messa
 			)
 
 			if err := waitOnIMAPUpdates(ctx, updates); err != nil {
-				return fmt.Errorf("failed to handle delete message event in gluon: %w", err)
+				return i, fmt.Errorf("failed to handle delete message event in gluon: %w", err)
 			}
 		}
 	}
 
-	return nil
+	s.messageEventsReporter.OnProgress(ctx, len(events), len(events))
+
+	return len(events), nil
 }
 
 func onMessageCreated(
@@ -166,6 +181,8 @@ func onMessageCreated(
 			s.log.WithError(err).Error("Failed to remove failed message ID from vault")
 		}
 
+		s.truncationReporter.report(ctx, res.messageID, res.truncated)
+
 		update = imap.NewMessagesCreated(allowUnknownLabels, res.update)
 		didPublish, err := safePublishMessageUpdate(ctx, s, full.AddressID, update)
 		if err != nil {
@@ -229,6 +246,8 @@ func onMessageUpdateDraftOrSent(ctx context.Context, s *Service, event proton.Me
 			s.log.WithError(err).Error("Failed to remove failed message ID from vault")
 		}
 
+		s.truncationReporter.report(ctx, res.messageID, res.truncated)
+
 		update = imap.NewMessageUpdated(
 			res.update.Message,
 			res.update.Literal,
@@ -19,22 +19,27 @@ package imapservice
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"html/template"
 	"time"
 
 	"github.com/ProtonMail/gluon/imap"
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/algo"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/message"
 	"github.com/bradenaw/juniper/xslices"
+	"github.com/sirupsen/logrus"
 )
 
 type buildRes struct {
 	messageID string
 	addressID string
 	update    *imap.MessageCreated
+	truncated string // Why the message was built incomplete, empty if it is complete.
 	err       error
 }
 
@@ -49,15 +54,64 @@ func defaultMessageJobOpts() message.JobOptions {
 	}
 }
 
+// decryptAndBuildRFC822Into is like message.DecryptAndBuildRFC822Into with the default job options, but it also returns
+// why the message was built incomplete, or an empty string if it is complete.
+func decryptAndBuildRFC822Into(kr *crypto.KeyRing, msg proton.Message, attData [][]byte, buf *bytes.Buffer) (string, error) {
+	decrypted := message.DecryptMessage(kr, msg, attData)
+
+	if err := message.BuildRFC822Into(kr, &decrypted, defaultMessageJobOpts(), buf); err != nil {
+		return "", err
+	}
+
+	return getTruncatedReason(&decrypted), nil
+}
+
+// getTruncatedReason returns which parts of the decrypted message could not be decrypted, and are thus built encrypted
+// since decryption errors are ignored, or an empty string if the message was fully decrypted.
+func getTruncatedReason(decrypted *message.DecryptedMessage) string {
+	failedAtts := xslices.CountFunc(decrypted.Attachments, func(att message.DecryptedAttachment) bool { return att.Err != nil })
+
+	switch {
+	case decrypted.BodyErr != nil && failedAtts > 0:
+		return fmt.Sprintf("body and %d attachment(s) could not be decrypted", failedAtts)
+
+	case decrypted.BodyErr != nil:
+		return "body could not be decrypted"
+
+	case failedAtts > 0:
+		return fmt.Sprintf("%d attachment(s) could not be decrypted", failedAtts)
+
+	default:
+		return ""
+	}
+}
+
+// publishMessageTruncated publishes a MessageTruncated event if the message was built incomplete for the given reason.
+func publishMessageTruncated(ctx context.Context, publisher events.EventPublisher, userID, messageID, reason string) {
+	if reason == "" {
+		return
+	}
+
+	logrus.WithField("messageID", messageID).WithField("reason", reason).Warn("Message is incomplete")
+
+	publisher.PublishEvent(ctx, events.MessageTruncated{
+		UserID:    userID,
+		MessageID: messageID,
+		Reason:    reason,
+		Count:     1,
+	})
+}
+
 func buildRFC822(apiLabels map[string]proton.Label, full proton.FullMessage, addrKR *crypto.KeyRing, buffer *bytes.Buffer) *buildRes {
 	var (
-		update *imap.MessageCreated
-		err    error
+		update    *imap.MessageCreated
+		truncated string
+		err       error
 	)
 
 	buffer.Grow(full.Size)
 
-	if buildErr := message.DecryptAndBuildRFC822Into(addrKR, full.Message, full.AttData, defaultMessageJobOpts(), buffer); buildErr != nil {
+	if reason, buildErr := decryptAndBuildRFC822Into(addrKR, full.Message, full.AttData, buffer); buildErr != nil {
 		update = newMessageCreatedFailedUpdate(apiLabels, full.MessageMetadata, buildErr)
 		err = buildErr
 	} else if created, parseErr := newMessageCreatedUpdate(apiLabels, full.MessageMetadata, buffer.Bytes()); parseErr != nil {
@@ -65,12 +119,14 @@ func buildRFC822(apiLabels map[string]proton.Label, full proton.FullMessage, add
 		err = parseErr
 	} else {
 		update = created
+		truncated = reason
 	}
 
 	return &buildRes{
 		messageID: full.ID,
 		addressID: full.AddressID,
 		update:    update,
+		truncated: truncated,
 		err:       err,
 	}
 }
@@ -24,6 +24,7 @@ import (
 
 	"github.com/ProtonMail/gluon/imap"
 	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/message"
 	"github.com/stretchr/testify/require"
 )
 
@@ -47,3 +48,24 @@ func TestNewFailedMessageLiteral(t *testing.T) {
 	require.Equal(t, `("text" "plain" () NIL NIL "base64" 114 2)`, parsed.Body)
 	require.Equal(t, `("text" "plain" () NIL NIL "base64" 114 2 NIL NIL NIL NIL)`, parsed.Structure)
 }
+
+func TestGetTruncatedReason(t *testing.T) {
+	failed := errors.New("oops")
+
+	require.Empty(t, getTruncatedReason(&message.DecryptedMessage{
+		Attachments: []message.DecryptedAttachment{{}},
+	}))
+
+	require.Equal(t, "body could not be decrypted", getTruncatedReason(&message.DecryptedMessage{
+		BodyErr: failed,
+	}))
+
+	require.Equal(t, "2 attachment(s) could not be decrypted", getTruncatedReason(&message.DecryptedMessage{
+		Attachments: []message.DecryptedAttachment{{Err: failed}, {}, {Err: failed}},
+	}))
+
+	require.Equal(t, "body and 1 attachment(s) could not be decrypted", getTruncatedReason(&message.DecryptedMessage{
+		BodyErr:     failed,
+		Attachments: []message.DecryptedAttachment{{Err: failed}},
+	}))
+}
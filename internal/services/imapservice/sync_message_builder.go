@@ -19,19 +19,20 @@ package imapservice
 
 import (
 	"bytes"
+	"context"
 
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/syncservice"
-	"github.com/ProtonMail/proton-bridge/v3/pkg/message"
 )
 
 type SyncMessageBuilder struct {
-	state *rwIdentity
+	state              *rwIdentity
+	truncationReporter *truncationReporter
 }
 
-func NewSyncMessageBuilder(rw *rwIdentity) *SyncMessageBuilder {
-	return &SyncMessageBuilder{state: rw}
+func NewSyncMessageBuilder(rw *rwIdentity, truncationReporter *truncationReporter) *SyncMessageBuilder {
+	return &SyncMessageBuilder{state: rw, truncationReporter: truncationReporter}
 }
 
 func (s SyncMessageBuilder) WithKeys(f func(*crypto.KeyRing, map[string]*crypto.KeyRing) error) error {
@@ -46,10 +47,13 @@ func (s SyncMessageBuilder) BuildMessage(
 ) (syncservice.BuildResult, error) {
 	buffer.Grow(full.Size)
 
-	if err := message.DecryptAndBuildRFC822Into(addrKR, full.Message, full.AttData, defaultMessageJobOpts(), buffer); err != nil {
+	truncated, err := decryptAndBuildRFC822Into(addrKR, full.Message, full.AttData, buffer)
+	if err != nil {
 		return syncservice.BuildResult{}, err
 	}
 
+	s.truncationReporter.report(context.Background(), full.ID, truncated)
+
 	update, err := newMessageCreatedUpdate(apiLabels, full.MessageMetadata, buffer.Bytes())
 	if err != nil {
 		return syncservice.BuildResult{}, err
@@ -75,6 +75,8 @@ func (rep *syncReporter) OnProgress(ctx context.Context, delta int64) {
 			Progress:  progress,
 			Elapsed:   time.Since(rep.start),
 			Remaining: remaining,
+			Processed: rep.count,
+			Total:     rep.total,
 		})
 
 		rep.last = time.Now()
@@ -0,0 +1,138 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+
+package imapservice
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/sirupsen/logrus"
+)
+
+// truncationReporter reports the messages served incomplete. The first one is reported right away; the ones which
+// follow are counted and reported together at most once per freq, so that a sync or a batch of events with many
+// messages which cannot be decrypted does not flood the event queue and the logs.
+type truncationReporter struct {
+	userID         string
+	eventPublisher events.EventPublisher
+	panicHandler   async.PanicHandler
+	freq           time.Duration
+
+	lock          sync.Mutex
+	timer         *time.Timer
+	pending       int
+	lastMessageID string
+	lastReason    string
+}
+
+func newTruncationReporter(
+	userID string,
+	eventPublisher events.EventPublisher,
+	panicHandler async.PanicHandler,
+	freq time.Duration,
+) *truncationReporter {
+	return &truncationReporter{
+		userID:         userID,
+		eventPublisher: eventPublisher,
+		panicHandler:   panicHandler,
+		freq:           freq,
+	}
+}
+
+// report reports that the given message was built incomplete for the given reason, if any.
+func (rep *truncationReporter) report(ctx context.Context, messageID, reason string) {
+	if reason == "" {
+		return
+	}
+
+	rep.lock.Lock()
+
+	if rep.timer != nil {
+		rep.pending++
+		rep.lastMessageID, rep.lastReason = messageID, reason
+		rep.lock.Unlock()
+
+		logrus.WithField("messageID", messageID).WithField("reason", reason).Debug("Message is incomplete")
+
+		return
+	}
+
+	rep.timer = time.AfterFunc(rep.freq, rep.flush)
+	rep.lock.Unlock()
+
+	rep.publish(ctx, messageID, reason, 1)
+}
+
+// flush reports the messages counted since the last report, if any. Otherwise, the next message is reported right away.
+func (rep *truncationReporter) flush() {
+	defer async.HandlePanic(rep.panicHandler)
+
+	rep.lock.Lock()
+
+	if rep.timer == nil {
+		rep.lock.Unlock()
+		return
+	}
+
+	count, messageID, reason := rep.pending, rep.lastMessageID, rep.lastReason
+	rep.pending = 0
+
+	if count > 0 {
+		rep.timer.Reset(rep.freq)
+	} else {
+		rep.timer = nil
+	}
+
+	rep.lock.Unlock()
+
+	if count > 0 {
+		rep.publish(context.Background(), messageID, reason, count)
+	}
+}
+
+// stop stops reporting; the messages counted since the last report are dropped.
+func (rep *truncationReporter) stop() {
+	rep.lock.Lock()
+	defer rep.lock.Unlock()
+
+	if rep.timer != nil {
+		rep.timer.Stop()
+		rep.timer = nil
+	}
+
+	rep.pending = 0
+}
+
+func (rep *truncationReporter) publish(ctx context.Context, messageID, reason string, count int) {
+	logrus.WithFields(logrus.Fields{
+		"messageID": messageID,
+		"reason":    reason,
+		"count":     count,
+	}).Warn("Message is incomplete")
+
+	rep.eventPublisher.PublishEvent(ctx, events.MessageTruncated{
+		UserID:    rep.userID,
+		MessageID: messageID,
+		Reason:    reason,
+		Count:     count,
+	})
+}
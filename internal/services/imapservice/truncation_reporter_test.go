@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+
+package imapservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/stretchr/testify/require"
+)
+
+func (r *eventRecorder) recorded() []events.Event {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return append([]events.Event{}, r.events...)
+}
+
+func TestTruncationReporter(t *testing.T) {
+	publisher := &eventRecorder{}
+	rep := newTruncationReporter("userID", publisher, nil, 100*time.Millisecond)
+	defer rep.stop()
+
+	ctx := context.Background()
+
+	// Complete messages are not reported.
+	rep.report(ctx, "complete", "")
+	require.Empty(t, publisher.recorded())
+
+	// The first message is reported right away.
+	rep.report(ctx, "1", "body could not be decrypted")
+	require.Equal(t, []events.Event{events.MessageTruncated{
+		UserID:    "userID",
+		MessageID: "1",
+		Reason:    "body could not be decrypted",
+		Count:     1,
+	}}, publisher.recorded())
+
+	// The ones which follow are reported together.
+	rep.report(ctx, "2", "body could not be decrypted")
+	rep.report(ctx, "3", "1 attachment(s) could not be decrypted")
+	require.Len(t, publisher.recorded(), 1)
+
+	require.Eventually(t, func() bool { return len(publisher.recorded()) == 2 }, time.Second, 10*time.Millisecond)
+	require.Equal(t, events.MessageTruncated{
+		UserID:    "userID",
+		MessageID: "3",
+		Reason:    "1 attachment(s) could not be decrypted",
+		Count:     2,
+	}, publisher.recorded()[1])
+
+	// Once nothing was counted for a while, the next message is reported right away again.
+	require.Eventually(t, func() bool {
+		rep.lock.Lock()
+		defer rep.lock.Unlock()
+
+		return rep.timer == nil
+	}, time.Second, 10*time.Millisecond)
+
+	rep.report(ctx, "4", "body could not be decrypted")
+	require.Len(t, publisher.recorded(), 3)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapservice
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+)
+
+// uploadReporter reports the progress of the messages appended by IMAP clients while they are uploaded to the server.
+// It is shared by all the connectors of a user. Progress is reported at most once per freq, and only once the upload
+// backlog has been going on for longer than freq, so that appending a single message does not produce any event.
+type uploadReporter struct {
+	userID         string
+	eventPublisher events.EventPublisher
+	freq           time.Duration
+
+	lock     sync.Mutex
+	done     int
+	total    int
+	last     time.Time
+	reported bool
+}
+
+func newUploadReporter(userID string, eventPublisher events.EventPublisher, freq time.Duration) *uploadReporter {
+	return &uploadReporter{
+		userID:         userID,
+		eventPublisher: eventPublisher,
+		freq:           freq,
+	}
+}
+
+// OnUploadStarted should be called when a message starts being uploaded.
+func (rep *uploadReporter) OnUploadStarted(ctx context.Context) {
+	rep.lock.Lock()
+	defer rep.lock.Unlock()
+
+	if rep.total == rep.done {
+		rep.done, rep.total = 0, 0
+		rep.last = time.Now()
+		rep.reported = false
+	}
+
+	rep.total++
+
+	rep.publishUnsafe(ctx, false)
+}
+
+// OnUploadDone should be called when a message has been uploaded, whether successfully or not.
+func (rep *uploadReporter) OnUploadDone(ctx context.Context) {
+	rep.lock.Lock()
+	defer rep.lock.Unlock()
+
+	rep.done++
+
+	// Always report the end of the backlog if its progress was reported.
+	rep.publishUnsafe(ctx, rep.done == rep.total && rep.reported)
+}
+
+func (rep *uploadReporter) publishUnsafe(ctx context.Context, force bool) {
+	if !force && time.Since(rep.last) < rep.freq {
+		return
+	}
+
+	rep.eventPublisher.PublishEvent(ctx, events.UploadProgress{
+		UserID: rep.userID,
+		Done:   rep.done,
+		Total:  rep.total,
+	})
+
+	rep.last = time.Now()
+	rep.reported = true
+}
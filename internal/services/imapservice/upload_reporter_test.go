@@ -0,0 +1,112 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapservice
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingPublisher struct {
+	lock   sync.Mutex
+	events []events.UploadProgress
+}
+
+func (p *recordingPublisher) PublishEvent(_ context.Context, event events.Event) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.events = append(p.events, event.(events.UploadProgress)) //nolint:forcetypeassert
+}
+
+func (p *recordingPublisher) published() []events.UploadProgress {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return append([]events.UploadProgress{}, p.events...)
+}
+
+func TestUploadReporter_SingleUploadIsNotReported(t *testing.T) {
+	publisher := &recordingPublisher{}
+	rep := newUploadReporter("userID", publisher, time.Second)
+
+	rep.OnUploadStarted(context.Background())
+	rep.OnUploadDone(context.Background())
+
+	require.Empty(t, publisher.published())
+}
+
+func TestUploadReporter_Throttled(t *testing.T) {
+	publisher := &recordingPublisher{}
+	rep := newUploadReporter("userID", publisher, 100*time.Millisecond)
+
+	for i := 0; i < 10; i++ {
+		rep.OnUploadStarted(context.Background())
+	}
+
+	for i := 0; i < 10; i++ {
+		time.Sleep(30 * time.Millisecond)
+		rep.OnUploadDone(context.Background())
+	}
+
+	published := publisher.published()
+
+	// Progress is reported, but not for every upload.
+	require.Greater(t, len(published), 1)
+	require.Less(t, len(published), 10)
+
+	for i, event := range published {
+		require.Equal(t, "userID", event.UserID)
+		require.Equal(t, 10, event.Total)
+
+		if i > 0 {
+			require.Greater(t, event.Done, published[i-1].Done)
+		}
+	}
+
+	// The end of the backlog is always reported.
+	require.Equal(t, 10, published[len(published)-1].Done)
+}
+
+func TestUploadReporter_NewBacklog(t *testing.T) {
+	publisher := &recordingPublisher{}
+	rep := newUploadReporter("userID", publisher, 50*time.Millisecond)
+
+	rep.OnUploadStarted(context.Background())
+	rep.OnUploadStarted(context.Background())
+	time.Sleep(100 * time.Millisecond)
+	rep.OnUploadDone(context.Background())
+	rep.OnUploadDone(context.Background())
+
+	require.Equal(t, []events.UploadProgress{
+		{UserID: "userID", Done: 1, Total: 2},
+		{UserID: "userID", Done: 2, Total: 2},
+	}, publisher.published())
+
+	// Once the backlog is done, the counters start over.
+	rep.OnUploadStarted(context.Background())
+	time.Sleep(100 * time.Millisecond)
+	rep.OnUploadDone(context.Background())
+
+	require.Equal(t, events.UploadProgress{UserID: "userID", Done: 1, Total: 1}, publisher.published()[2])
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import "container/heap"
+
+// expiryHeap is a min-heap of send entries ordered by expiry time.
+// It lets the recorder prune expired entries without sweeping the whole entry map.
+type expiryHeap []*sendEntry
+
+func (q expiryHeap) Len() int {
+	return len(q)
+}
+
+func (q expiryHeap) Less(i, j int) bool {
+	return q[i].exp.Before(q[j].exp)
+}
+
+func (q expiryHeap) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].heapIndex = i
+	q[j].heapIndex = j
+}
+
+func (q *expiryHeap) Push(x any) {
+	entry := x.(*sendEntry) //nolint:forcetypeassert
+
+	entry.heapIndex = len(*q)
+
+	*q = append(*q, entry)
+}
+
+func (q *expiryHeap) Pop() any {
+	old := *q
+	n := len(old)
+
+	entry := old[n-1]
+	old[n-1] = nil
+	entry.heapIndex = -1
+
+	*q = old[:n-1]
+
+	return entry
+}
+
+// peek returns the entry closest to expiry, or nil if the heap is empty.
+func (q expiryHeap) peek() *sendEntry {
+	if len(q) == 0 {
+		return nil
+	}
+
+	return q[0]
+}
+
+// remove removes the given entry from the heap, if it is still in it.
+func (q *expiryHeap) remove(entry *sendEntry) {
+	if entry.heapIndex < 0 || entry.heapIndex >= len(*q) || (*q)[entry.heapIndex] != entry {
+		return
+	}
+
+	heap.Remove(q, entry.heapIndex)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+)
+
+// FileStoreFlushDelay is how long a FileStore waits after a change before writing the file, so that the changes made
+// in the meantime are written at once.
+const FileStoreFlushDelay = time.Second
+
+// gzipMagic are the first bytes of gzip-compressed data, by which compressed files are told apart on load.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// FileStore is a PersistentStore which keeps the entries in a JSON file. Changes are applied in memory and the file is
+// rewritten in the background, FileStoreFlushDelay after the first change not yet written, so that saving an entry does
+// not block on the disk. Flush writes the pending changes right away.
+// The file is written gzip-compressed if compression is enabled; it is read whether it is compressed or not, so that
+// compression can be enabled or disabled on existing files.
+type FileStore struct {
+	path     string
+	compress bool
+
+	entries    map[fileStoreKey]PersistedEntry
+	flushTimer *time.Timer
+	lock       sync.Mutex
+
+	// writeLock serializes the writes of the file, which happen outside of lock.
+	writeLock sync.Mutex
+}
+
+type fileStoreKey struct {
+	hash, msgID string
+}
+
+// NewFileStore creates a store which persists the entries in the file at the given path.
+func NewFileStore(path string, compress bool) *FileStore {
+	return &FileStore{
+		path:     path,
+		compress: compress,
+		entries:  make(map[fileStoreKey]PersistedEntry),
+	}
+}
+
+func (s *FileStore) Save(hash, msgID string, toList []string, exp time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.entries[fileStoreKey{hash: hash, msgID: msgID}] = PersistedEntry{Hash: hash, MsgID: msgID, ToList: toList, Exp: exp}
+
+	s.scheduleFlushUnsafe()
+
+	return nil
+}
+
+func (s *FileStore) LoadAll() ([]PersistedEntry, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err := s.loadUnsafe(); err != nil {
+		return nil, err
+	}
+
+	return maps.Values(s.entries), nil
+}
+
+func (s *FileStore) Delete(hash, msgID string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := fileStoreKey{hash: hash, msgID: msgID}
+
+	if _, ok := s.entries[key]; !ok {
+		return nil
+	}
+
+	delete(s.entries, key)
+
+	s.scheduleFlushUnsafe()
+
+	return nil
+}
+
+// Flush writes the pending changes, if any, to the file.
+func (s *FileStore) Flush() error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	s.lock.Lock()
+
+	if s.flushTimer == nil {
+		s.lock.Unlock()
+		return nil
+	}
+
+	s.flushTimer.Stop()
+	s.flushTimer = nil
+
+	entries := maps.Values(s.entries)
+
+	s.lock.Unlock()
+
+	return s.write(entries)
+}
+
+// scheduleFlushUnsafe schedules the pending changes to be written, unless they already are.
+func (s *FileStore) scheduleFlushUnsafe() {
+	if s.flushTimer != nil {
+		return
+	}
+
+	s.flushTimer = time.AfterFunc(FileStoreFlushDelay, func() {
+		if err := s.Flush(); err != nil {
+			logrus.WithError(err).Warn("Failed to write send recorder entries")
+		}
+	})
+}
+
+func (s *FileStore) write(entries []PersistedEntry) error {
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("failed to marshal send recorder entries: %w", err)
+	}
+
+	if s.compress {
+		if data, err = compress(data); err != nil {
+			return fmt.Errorf("failed to compress send recorder entries: %w", err)
+		}
+	}
+
+	tmpFile := s.path + ".tmp"
+
+	if err := os.WriteFile(tmpFile, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write send recorder entries to tmp file: %w", err)
+	}
+
+	if err := os.Rename(tmpFile, s.path); err != nil {
+		return fmt.Errorf("failed to update send recorder entries: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FileStore) loadUnsafe() error {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to read send recorder entries: %w", err)
+	}
+
+	if bytes.HasPrefix(data, gzipMagic) {
+		if data, err = decompress(data); err != nil {
+			return fmt.Errorf("failed to decompress send recorder entries: %w", err)
+		}
+	}
+
+	var entries []PersistedEntry
+
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("failed to unmarshal send recorder entries: %w", err)
+	}
+
+	s.entries = make(map[fileStoreKey]PersistedEntry, len(entries))
+
+	for _, entry := range entries {
+		s.entries[fileStoreKey{hash: entry.Hash, msgID: entry.MsgID}] = entry
+	}
+
+	return nil
+}
+
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := gzip.NewWriter(&buf)
+
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = r.Close() }()
+
+	return io.ReadAll(r)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore_RoundTrip(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		path := filepath.Join(t.TempDir(), "sendrecorder")
+
+		exp := time.Now().Add(time.Hour).Round(0)
+
+		store := NewFileStore(path, compress)
+		require.NoError(t, store.Save("hash1", "abc", []string{"to@pm.me"}, exp))
+		require.NoError(t, store.Save("hash2", "def", nil, exp))
+		require.NoError(t, store.Delete("hash2", "def"))
+		require.NoError(t, store.Flush())
+
+		// The file is compressed iff compression is enabled.
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, compress, len(data) > 2 && data[0] == gzipMagic[0] && data[1] == gzipMagic[1])
+
+		entries, err := NewFileStore(path, compress).LoadAll()
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "hash1", entries[0].Hash)
+		require.Equal(t, "abc", entries[0].MsgID)
+		require.Equal(t, []string{"to@pm.me"}, entries[0].ToList)
+		require.True(t, exp.Equal(entries[0].Exp))
+	}
+}
+
+func TestFileStore_LoadLegacyUncompressed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sendrecorder")
+
+	exp := time.Now().Add(time.Hour).Round(0)
+
+	data, err := json.Marshal([]PersistedEntry{{Hash: "hash", MsgID: "abc", Exp: exp}})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	// A store with compression enabled reads the uncompressed file, and rewrites it compressed.
+	store := NewFileStore(path, true)
+
+	entries, err := store.LoadAll()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "abc", entries[0].MsgID)
+
+	require.NoError(t, store.Save("hash2", "def", nil, exp))
+	require.NoError(t, store.Flush())
+
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, gzipMagic, data[:2])
+
+	entries, err = NewFileStore(path, false).LoadAll()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
+
+func TestFileStore_FlushesInBackground(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sendrecorder")
+
+	store := NewFileStore(path, true)
+	require.NoError(t, store.Save("hash", "abc", nil, time.Now().Add(time.Hour)))
+
+	// The file is not written by Save itself, but shortly after.
+	require.NoFileExists(t, path)
+
+	require.Eventually(t, func() bool {
+		entries, err := NewFileStore(path, true).LoadAll()
+		return err == nil && len(entries) == 1
+	}, 5*FileStoreFlushDelay, 10*time.Millisecond)
+
+	// Nothing is left to flush.
+	require.NoError(t, store.Flush())
+}
+
+func TestFileStore_Missing(t *testing.T) {
+	entries, err := NewFileStore(filepath.Join(t.TempDir(), "sendrecorder"), true).LoadAll()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestSendHasher_FileStore_SurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sendrecorder")
+
+	store := NewFileStore(path, true)
+
+	h, err := NewSendRecorderWithStore(SendEntryExpiry, SendMaxEntries, store)
+	require.NoError(t, err)
+
+	srID, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second), "to@pm.me")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	h.SignalMessageSent(hash, srID, "abc")
+	require.NoError(t, store.Flush())
+
+	// Simulate a restart.
+	h, err = NewSendRecorderWithStore(SendEntryExpiry, SendMaxEntries, NewFileStore(path, true))
+	require.NoError(t, err)
+
+	_, messageID, ok, err := h.TryInsertWaitGetID(context.Background(), hash, []string{"to@pm.me"}, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, "abc", messageID)
+}
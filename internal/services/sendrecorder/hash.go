@@ -0,0 +1,399 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"io"
+	"mime/quotedprintable"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/gluon/rfc5322"
+	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// IdempotencyKeyHeader is the header with which clients, typically scripts, can guarantee that a message is sent at
+// most once: messages with the same key are duplicates, whatever their content and recipients.
+const IdempotencyKeyHeader = "X-Idempotency-Key"
+
+// idempotencyHashPrefix marks the hashes computed from an idempotency key, whose entries match any recipients.
+const idempotencyHashPrefix = "idempotency:"
+
+// hashedHeaders are the top-level headers which take part in the message hash, in the order they are hashed.
+var hashedHeaders = []string{"Subject", "From", "To", "Cc", "Reply-To", "In-Reply-To"}
+
+// addressHeaders are the hashed headers holding address lists; they are normalized before being hashed.
+var addressHeaders = []string{"From", "To", "Cc", "Reply-To"}
+
+// headerUnfolder removes the line breaks of folded header values, keeping the whitespace which follows them.
+var headerUnfolder = strings.NewReplacer("\r\n ", " ", "\r\n\t", "\t", "\n ", " ", "\n\t", "\t") //nolint:gochecknoglobals
+
+// Hasher computes the hash under which a message is recorded. Any hasher must be:
+// - stable: the same message always hashes the same, across calls and restarts (hashes may be persisted),
+// - content-sensitive: messages which should not be deduplicated, e.g. with distinct bodies, hash differently,
+// - strict: it returns an error, rather than some hash, if the message cannot be parsed.
+// The hash is an opaque string; a hasher may be cheaper or more lenient than GetMessageHash, which is the default.
+type Hasher func(b []byte) (string, error)
+
+// hashOptions configures how messages are hashed.
+type hashOptions struct {
+	// ignoredHeaders are lowercase glob patterns (as understood by path.Match) of header names left out of the hash.
+	ignoredHeaders []string
+
+	// useMessageID makes the Message-ID header, when present, take precedence over the message content.
+	useMessageID bool
+
+	// includeSignatures makes detached signature parts (e.g. of multipart/signed messages) part of the hash.
+	includeSignatures bool
+
+	// profile selects the normalizations applied before hashing; the zero value selects the lenient profile.
+	profile NormalizationProfile
+
+	// includeStructure makes the number of MIME parts and the length of the message part of the hash, as a guard
+	// against structurally different messages whose hashed headers and bodies happen to match.
+	includeStructure bool
+
+	// dateBucket, if positive, makes the Date header, truncated to a multiple of dateBucket, part of the hash, so that
+	// the same content sent at distinct times does not hash the same.
+	dateBucket time.Duration
+}
+
+// signatureTypes are the MIME types of detached signature parts, which differ on every send of the same content.
+var signatureTypes = []rfc822.MIMEType{
+	"application/pgp-signature",
+	"application/pkcs7-signature",
+	"application/x-pkcs7-signature",
+}
+
+// isIdempotencyHash returns whether the hash, as returned by getMessageHash, was computed from an idempotency key.
+// Content hashes are plain base64, so they never carry the prefix. It says nothing about hashes from other hashers.
+func isIdempotencyHash(hash string) bool {
+	return strings.HasPrefix(hash, idempotencyHashPrefix)
+}
+
+// StripIdempotencyKey returns the given message without its X-Idempotency-Key headers, which are only meant for bridge.
+// The message is returned as is if it has none or if its header cannot be parsed.
+func StripIdempotencyKey(b []byte) []byte {
+	section := rfc822.Parse(bytes.Clone(b))
+
+	header, err := section.ParseHeader()
+	if err != nil || !header.Has(IdempotencyKeyHeader) {
+		return b
+	}
+
+	body := bytes.Clone(section.Body())
+
+	for header.Has(IdempotencyKeyHeader) {
+		header.Del(IdempotencyKeyHeader)
+	}
+
+	return append(bytes.Clone(header.Raw()), body...)
+}
+
+// parseIgnoredHeaders validates the given header name patterns and returns them in the form used by hashOptions.
+func parseIgnoredHeaders(ignoredHeaders []string) ([]string, error) {
+	patterns := make([]string, 0, len(ignoredHeaders))
+
+	for _, pattern := range ignoredHeaders {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid ignored header pattern %q: %w", pattern, err)
+		}
+
+		patterns = append(patterns, pattern)
+	}
+
+	return patterns, nil
+}
+
+// isIgnored returns whether the given header is excluded from the hash.
+func (opts hashOptions) isIgnored(key string) bool {
+	key = strings.ToLower(key)
+
+	return slices.ContainsFunc(opts.ignoredHeaders, func(pattern string) bool {
+		ok, _ := path.Match(pattern, key)
+		return ok
+	})
+}
+
+// GetMessageHash returns the hash of the given message.
+// If the message has a non-empty X-Idempotency-Key header, the hash is computed from the key alone.
+// Otherwise, this takes into account:
+// - the Subject header,
+// - the From/To/Cc/Reply-To/In-Reply-To headers, including every occurrence of a duplicated header,
+// - where the From/To/Cc/Reply-To addresses are compared regardless of display names, order and domain case,
+// - where the other header values are compared regardless of folding and runs of whitespace,
+// - the Content-Type header of each (leaf) part, except detached signature parts,
+// - the Content-Disposition header of each (leaf) part,
+// - the (decoded) body of each part.
+func GetMessageHash(b []byte) (string, error) {
+	return getMessageHash(b, hashOptions{})
+}
+
+// GetMessageHashWithMessageID returns the hash of the given message, giving precedence to its Message-ID header:
+// - if the message has a non-empty Message-ID header, the hash is computed from the Message-ID alone, so two messages
+// with the same Message-ID always hash the same, even if their content differs,
+// - otherwise, the hash is the content-based hash returned by GetMessageHash.
+func GetMessageHashWithMessageID(b []byte) (string, error) {
+	return getMessageHash(b, hashOptions{useMessageID: true})
+}
+
+func getMessageHash(b []byte, opts hashOptions) (string, error) {
+	section := rfc822.Parse(b)
+
+	header, err := section.ParseHeader()
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	norm := opts.profile.normalizations()
+
+	if key := strings.TrimSpace(header.Get(IdempotencyKeyHeader)); key != "" {
+		if _, err := h.Write([]byte(IdempotencyKeyHeader + ":" + key)); err != nil {
+			return "", err
+		}
+
+		return idempotencyHashPrefix + base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+	}
+
+	if messageID := strings.TrimSpace(header.Get("Message-Id")); opts.useMessageID && messageID != "" {
+		if _, err := h.Write([]byte("Message-Id:" + messageID)); err != nil {
+			return "", err
+		}
+
+		return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+	}
+
+	for _, key := range hashedHeaders {
+		if opts.isIgnored(key) {
+			continue
+		}
+
+		if err := hashHeader(h, header, key, norm); err != nil {
+			return "", err
+		}
+	}
+
+	if opts.dateBucket > 0 {
+		if err := hashDateBucket(h, header, opts.dateBucket); err != nil {
+			return "", err
+		}
+	}
+
+	var parts int
+
+	if err := section.Walk(func(section *rfc822.Section) error {
+		parts++
+
+		children, err := section.Children()
+		if err != nil {
+			return err
+		} else if len(children) > 0 {
+			return nil
+		}
+
+		header, err := section.ParseHeader()
+		if err != nil {
+			return err
+		}
+
+		contentType := header.Get("Content-Type")
+		mimeType, values, err := rfc822.ParseMIMEType(contentType)
+		if err == nil && !opts.includeSignatures && slices.Contains(signatureTypes, mimeType) {
+			return nil
+		}
+
+		if err != nil {
+			logrus.Warnf("Message contains invalid mime type: %v", contentType)
+		} else if !opts.isIgnored("Content-Type") {
+			if _, err := h.Write([]byte(mimeType)); err != nil {
+				return err
+			}
+
+			keys := maps.Keys(values)
+			slices.Sort(keys)
+
+			for _, k := range keys {
+				if strings.EqualFold(k, "boundary") || (norm.charset && strings.EqualFold(k, "charset")) {
+					continue
+				}
+
+				if _, err := h.Write([]byte(k)); err != nil {
+					return err
+				}
+
+				if _, err := h.Write([]byte(values[k])); err != nil {
+					return err
+				}
+			}
+		}
+
+		if !opts.isIgnored("Content-Disposition") {
+			if _, err := h.Write([]byte(header.Get("Content-Disposition"))); err != nil {
+				return err
+			}
+		}
+
+		return hashBody(h, section.Body(), mimeType, header.Get("Content-Transfer-Encoding"), norm)
+	}); err != nil {
+		return "", err
+	}
+
+	if opts.includeStructure {
+		if _, err := fmt.Fprintf(h, "Parts:%d,Length:%d", parts, len(b)); err != nil {
+			return "", err
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashHeader writes every occurrence of the given header, in order, to the hash.
+// Occurrences after the first are prefixed with a separator, so that a message with a duplicated header never hashes
+// like the message with a single occurrence.
+func hashHeader(h hash.Hash, header *rfc822.Header, key string, norm normalizations) error {
+	var values []string
+
+	header.Entries(func(k, v string) {
+		if !strings.EqualFold(strings.TrimSpace(k), key) {
+			return
+		}
+
+		if norm.headerWhitespace {
+			v = unfoldHeaderValue(v)
+		}
+
+		// Address lists are parsed as they are sent, once unfolded, so that whitespace within quoted strings is kept.
+		if norm.addressLists && slices.Contains(addressHeaders, key) {
+			if normalized, ok := normalizeAddressList(v); ok {
+				values = append(values, normalized)
+				return
+			}
+		}
+
+		if norm.headerWhitespace {
+			v = normalizeHeaderValue(v)
+		}
+
+		values = append(values, v)
+	})
+
+	for idx, value := range values {
+		if idx > 0 {
+			if _, err := h.Write([]byte{0}); err != nil {
+				return err
+			}
+		}
+
+		if _, err := h.Write([]byte(value)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unfoldHeaderValue removes the line breaks which fold the given header value over several lines, as per RFC 5322.
+func unfoldHeaderValue(value string) string {
+	return headerUnfolder.Replace(value)
+}
+
+// normalizeHeaderValue returns the given header value unfolded, without surrounding whitespace, and with every run of
+// whitespace replaced by a single space, so that "Hello   world" and "Hello\r\n world" hash the same.
+func normalizeHeaderValue(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// hashDateBucket writes the Date header, truncated to a multiple of the given bucket, to the hash. Messages without a
+// valid Date header hash as if the option was disabled.
+func hashDateBucket(h hash.Hash, header *rfc822.Header, bucket time.Duration) error {
+	value := header.Get("Date")
+	if value == "" {
+		return nil
+	}
+
+	date, err := rfc5322.ParseDateTime(value)
+	if err != nil {
+		logrus.WithError(err).Warn("Message contains invalid date, leaving it out of the hash")
+		return nil
+	}
+
+	_, err = fmt.Fprintf(h, "Date:%d", date.Truncate(bucket).Unix())
+
+	return err
+}
+
+// normalizeAddressList returns the canonical form of the given address list: the addresses alone, without display
+// names, with lowercase domains, sorted and deduplicated. It returns false if the list cannot be parsed.
+func normalizeAddressList(value string) (string, bool) {
+	addrs, err := rfc5322.ParseAddressList(value)
+	if err != nil {
+		return "", false
+	}
+
+	normalized := make([]string, 0, len(addrs))
+
+	for _, addr := range addrs {
+		if idx := strings.LastIndex(addr.Address, "@"); idx >= 0 {
+			normalized = append(normalized, addr.Address[:idx]+strings.ToLower(addr.Address[idx:]))
+		} else {
+			normalized = append(normalized, addr.Address)
+		}
+	}
+
+	slices.Sort(normalized)
+
+	return strings.Join(slices.Compact(normalized), ","), true
+}
+
+// hashBody writes the given body, normalized, to the writer. Text bodies are streamed through their transfer decoding,
+// so that the decoded body is never held in memory as a whole.
+func hashBody(writer io.Writer, body []byte, mimeType rfc822.MIMEType, encoding string, norm normalizations) error {
+	var reader io.Reader = bytes.NewReader(body)
+
+	// We need to remove the transfer encoding from the text part as it is possible the that encoding sent to SMTP
+	// is different than the one sent to the IMAP client.
+	if (mimeType == rfc822.TextHTML || mimeType == rfc822.TextPlain) && norm.transferEncoding {
+		switch strings.ToLower(encoding) {
+		case "quoted-printable":
+			reader = quotedprintable.NewReader(reader)
+
+		case "base64":
+			reader = base64.NewDecoder(base64.StdEncoding, reader)
+		}
+	}
+
+	normalizer := newBodyNormalizer(writer, norm)
+
+	if _, err := io.Copy(normalizer, reader); err != nil {
+		return err
+	}
+
+	return normalizer.Close()
+}
@@ -0,0 +1,375 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NormalizationProfile selects which normalizations are applied to a message before it is hashed. Clients serialize
+// the same content differently, so a profile can be chosen to fit the clients in use.
+type NormalizationProfile string
+
+const (
+	// NormalizationProfileStrict hashes headers and bodies as they are sent, without any normalization.
+	NormalizationProfileStrict NormalizationProfile = "strict"
+
+	// NormalizationProfileLenient ignores line endings, surrounding whitespace, transfer encodings, header folding and
+	// whitespace, and the form of address lists. It is the default.
+	NormalizationProfileLenient NormalizationProfile = "lenient"
+
+	// NormalizationProfileThunderbird is the lenient profile, also ignoring trailing whitespace on body lines, which
+	// Thunderbird adds to the soft line breaks of format=flowed text.
+	NormalizationProfileThunderbird NormalizationProfile = "thunderbird"
+
+	// NormalizationProfileAppleMail is the Thunderbird profile, also ignoring the charset of text parts, which Apple
+	// Mail picks depending on the content of the message.
+	NormalizationProfileAppleMail NormalizationProfile = "apple-mail"
+)
+
+var ErrUnknownNormalizationProfile = errors.New("unknown normalization profile")
+
+// normalizations are the normalizations applied to a message before it is hashed.
+type normalizations struct {
+	// lineEndings removes carriage returns from bodies.
+	lineEndings bool
+
+	// trimBody removes leading and trailing whitespace from bodies.
+	trimBody bool
+
+	// trailingSpace removes trailing whitespace from every body line.
+	trailingSpace bool
+
+	// transferEncoding decodes the transfer encoding of text bodies.
+	transferEncoding bool
+
+	// addressLists replaces address list headers with their canonical form, see normalizeAddressList.
+	addressLists bool
+
+	// headerWhitespace unfolds hashed header values and collapses their runs of whitespace, see normalizeHeaderValue.
+	headerWhitespace bool
+
+	// charset leaves the charset parameter of the Content-Type header out of the hash.
+	charset bool
+}
+
+var profileNormalizations = map[NormalizationProfile]normalizations{ //nolint:gochecknoglobals
+	NormalizationProfileStrict: {},
+	NormalizationProfileLenient: {
+		lineEndings:      true,
+		trimBody:         true,
+		transferEncoding: true,
+		addressLists:     true,
+		headerWhitespace: true,
+	},
+	NormalizationProfileThunderbird: {
+		lineEndings:      true,
+		trimBody:         true,
+		trailingSpace:    true,
+		transferEncoding: true,
+		addressLists:     true,
+		headerWhitespace: true,
+	},
+	NormalizationProfileAppleMail: {
+		lineEndings:      true,
+		trimBody:         true,
+		trailingSpace:    true,
+		transferEncoding: true,
+		addressLists:     true,
+		headerWhitespace: true,
+		charset:          true,
+	},
+}
+
+// parseNormalizationProfile validates the given profile name. An empty name selects the default, lenient profile.
+func parseNormalizationProfile(profile string) (NormalizationProfile, error) {
+	if profile == "" {
+		return NormalizationProfileLenient, nil
+	}
+
+	if _, ok := profileNormalizations[NormalizationProfile(profile)]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownNormalizationProfile, profile)
+	}
+
+	return NormalizationProfile(profile), nil
+}
+
+// normalizations returns the normalizations of the profile, falling back to the lenient profile.
+func (profile NormalizationProfile) normalizations() normalizations {
+	if norm, ok := profileNormalizations[profile]; ok {
+		return norm
+	}
+
+	return profileNormalizations[NormalizationProfileLenient]
+}
+
+// bodyNormalizer applies the body normalizations to a (decoded) body streamed through it, writing the normalized body
+// to the underlying writer without holding the whole body in memory. Only whitespace which may be trimmed is held
+// back, until it is known whether it is trailing. The body is complete once Close is called.
+type bodyNormalizer struct {
+	w    io.Writer
+	norm normalizations
+
+	// started is set once a character which is not trimmed as leading whitespace was seen.
+	started bool
+
+	// pending holds the whitespace seen since the last character written, which may turn out to be trailing.
+	pending []byte
+
+	// partial holds the start of a multi-byte character split across writes.
+	partial []byte
+
+	// buf gathers the normalized body, so that it is written in large chunks rather than line by line.
+	buf []byte
+}
+
+// bodyNormalizerBufferSize is the size of the chunks written by a bodyNormalizer.
+const bodyNormalizerBufferSize = 32 * 1024
+
+func newBodyNormalizer(w io.Writer, norm normalizations) *bodyNormalizer {
+	return &bodyNormalizer{w: w, norm: norm}
+}
+
+func (n *bodyNormalizer) Write(p []byte) (int, error) {
+	data := p
+
+	if len(n.partial) > 0 {
+		data = append(n.partial, p...)
+		n.partial = nil
+	}
+
+	// written is the start of the bytes which are written as they are, up to the current character.
+	var written int
+
+	for idx := 0; idx < len(data); {
+		// ASCII characters above space, the bulk of any body, are never whitespace, and the single spaces between them are
+		// never trailing whitespace; they are skipped over without further checks.
+		if c := data[idx]; isNonSpaceASCII(c) {
+			if len(n.pending) > 0 {
+				if err := n.flush(); err != nil {
+					return 0, err
+				}
+			}
+
+			n.started = true
+
+			for idx++; idx < len(data); idx++ {
+				if isNonSpaceASCII(data[idx]) {
+					continue
+				}
+
+				if data[idx] == ' ' && idx+1 < len(data) && isNonSpaceASCII(data[idx+1]) {
+					idx++
+					continue
+				}
+
+				break
+			}
+
+			continue
+		}
+
+		char, size := rune(data[idx]), 1
+
+		switch {
+		case char == '\r' && n.norm.lineEndings:
+			if err := n.write(data[written:idx]); err != nil {
+				return 0, err
+			}
+
+			idx++
+			written = idx
+
+			continue
+
+		case char >= utf8.RuneSelf && !utf8.FullRune(data[idx:]):
+			if err := n.write(data[written:idx]); err != nil {
+				return 0, err
+			}
+
+			n.partial = append([]byte(nil), data[idx:]...)
+
+			return len(p), nil
+
+		case char >= utf8.RuneSelf:
+			char, size = utf8.DecodeRune(data[idx:])
+		}
+
+		if n.isTrimmable(char) {
+			if err := n.write(data[written:idx]); err != nil {
+				return 0, err
+			}
+
+			n.hold(data[idx : idx+size])
+
+			idx += size
+			written = idx
+
+			continue
+		}
+
+		// Any whitespace held back is not trailing after all, except at the end of a line.
+		if char == '\n' && n.norm.trailingSpace && !n.norm.trimBody {
+			n.pending = n.pending[:0]
+		} else if err := n.flush(); err != nil {
+			return 0, err
+		}
+
+		n.started = true
+		idx += size
+	}
+
+	if err := n.write(data[written:]); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close completes the body, dropping the whitespace held back, which is trailing.
+func (n *bodyNormalizer) Close() error {
+	if len(n.partial) > 0 {
+		if err := n.flush(); err != nil {
+			return err
+		}
+
+		if err := n.write(n.partial); err != nil {
+			return err
+		}
+
+		n.partial = nil
+	}
+
+	n.pending = n.pending[:0]
+
+	return n.flushBuffer()
+}
+
+// isNonSpaceASCII returns whether the given byte is an ASCII character above space, which is never whitespace.
+func isNonSpaceASCII(c byte) bool {
+	return c > ' ' && c < utf8.RuneSelf
+}
+
+// isTrimmable returns whether the given character may have to be removed, depending on the characters that follow.
+func (n *bodyNormalizer) isTrimmable(char rune) bool {
+	switch {
+	case n.norm.trimBody:
+		return unicode.IsSpace(char)
+
+	case n.norm.trailingSpace:
+		return char == ' ' || char == '\t'
+
+	default:
+		return false
+	}
+}
+
+// hold holds back the given whitespace, unless it is leading whitespace to be trimmed.
+func (n *bodyNormalizer) hold(b []byte) {
+	if n.norm.trimBody && !n.started {
+		return
+	}
+
+	n.pending = append(n.pending, b...)
+}
+
+// flush writes the whitespace held back, without the trailing whitespace of the lines it ends.
+func (n *bodyNormalizer) flush() error {
+	if len(n.pending) == 0 {
+		return nil
+	}
+
+	pending := n.pending
+
+	if n.norm.trailingSpace {
+		pending = trimLinesTrailingSpace(pending)
+	}
+
+	err := n.write(pending)
+	n.pending = n.pending[:0]
+
+	return err
+}
+
+// trimLinesTrailingSpace removes, in place, the spaces and tabs at the end of every line ended in the given whitespace.
+// Bytes of multi-byte characters are never spaces, tabs or line feeds, so the whitespace can be scanned byte by byte.
+func trimLinesTrailingSpace(b []byte) []byte {
+	trimmed, trailing := b[:0], -1
+
+	for _, c := range b {
+		switch c {
+		case ' ', '\t':
+			if trailing < 0 {
+				trailing = len(trimmed)
+			}
+
+		case '\n':
+			if trailing >= 0 {
+				trimmed = trimmed[:trailing]
+			}
+
+			trailing = -1
+
+		default:
+			trailing = -1
+		}
+
+		trimmed = append(trimmed, c)
+	}
+
+	return trimmed
+}
+
+// write buffers the given normalized bytes, writing the buffer once it is full.
+func (n *bodyNormalizer) write(b []byte) error {
+	if len(n.buf)+len(b) > bodyNormalizerBufferSize {
+		if err := n.flushBuffer(); err != nil {
+			return err
+		}
+
+		// Large chunks are written as they are rather than copied.
+		if len(b) > bodyNormalizerBufferSize {
+			_, err := n.w.Write(b)
+			return err
+		}
+	}
+
+	if n.buf == nil {
+		n.buf = make([]byte, 0, bodyNormalizerBufferSize)
+	}
+
+	n.buf = append(n.buf, b...)
+
+	return nil
+}
+
+// flushBuffer writes the buffered bytes.
+func (n *bodyNormalizer) flushBuffer() error {
+	if len(n.buf) == 0 {
+		return nil
+	}
+
+	_, err := n.w.Write(n.buf)
+	n.buf = n.buf[:0]
+
+	return err
+}
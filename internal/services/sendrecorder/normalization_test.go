@@ -0,0 +1,93 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBodyNormalizer(t *testing.T) {
+	// Bodies mixing the characters subject to normalization, including multi-byte and invalid ones.
+	fragments := []string{"a", "é", " ", "  ", "\t", "\r", "\n", "\r\n", " ", " ", "\xe2", "\x85", "hello world"}
+
+	bodies := []string{"", " ", "\r\n", "hello", " hello \r\n", "hello \t\r\nworld \r\n\r\n", " hello ", "\xe2\x80"}
+
+	rng := rand.New(rand.NewSource(1)) //nolint:gosec
+
+	for i := 0; i < 500; i++ {
+		var body string
+
+		for j := rng.Intn(20); j >= 0; j-- {
+			body += fragments[rng.Intn(len(fragments))]
+		}
+
+		bodies = append(bodies, body)
+	}
+
+	for profile, norm := range profileNormalizations {
+		for _, body := range bodies {
+			expected := norm.normalizeBody([]byte(body))
+
+			// The body is normalized the same whether it is written at once or split anywhere, even within characters.
+			for _, chunkSize := range []int{len(body) + 1, 1, 2, 3, 7} {
+				var out bytes.Buffer
+
+				normalizer := newBodyNormalizer(&out, norm)
+
+				for b := []byte(body); len(b) > 0; {
+					chunk := b[:min(chunkSize, len(b))]
+					b = b[len(chunk):]
+
+					n, err := normalizer.Write(chunk)
+					require.NoError(t, err)
+					require.Equal(t, len(chunk), n)
+				}
+
+				require.NoError(t, normalizer.Close())
+				require.Equal(t, string(expected), out.String(), "profile %v, body %q, chunk size %v", profile, body, chunkSize)
+			}
+		}
+	}
+}
+
+func TestBodyNormalizer_LargeBody(t *testing.T) {
+	body := bytes.Repeat([]byte("Hello world!  \r\n"), 10*bodyNormalizerBufferSize)
+	norm := NormalizationProfileThunderbird.normalizations()
+
+	var out bytes.Buffer
+
+	normalizer := newBodyNormalizer(&out, norm)
+
+	_, err := normalizer.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, normalizer.Close())
+
+	require.Equal(t, norm.normalizeBody(body), out.Bytes())
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// Transition is a change in the state of a recorder entry.
+type Transition int
+
+const (
+	// TransitionInsert is reported when an entry is inserted for a message about to be sent.
+	TransitionInsert Transition = iota
+
+	// TransitionAck is reported when the message of an entry was sent successfully.
+	TransitionAck
+
+	// TransitionFail is reported when the message of an entry failed to send and the entry was removed.
+	TransitionFail
+
+	// TransitionExpire is reported when an entry is removed because it expired.
+	TransitionExpire
+
+	// TransitionEvict is reported when an entry is removed to keep the recorder under its maximum number of entries.
+	TransitionEvict
+
+	// TransitionForget is reported when an entry is removed on request, with Forget.
+	TransitionForget
+
+	// TransitionCancel is reported when an entry is removed because its send was cancelled, with CancelSend.
+	TransitionCancel
+)
+
+func (t Transition) String() string {
+	switch t {
+	case TransitionInsert:
+		return "insert"
+
+	case TransitionAck:
+		return "ack"
+
+	case TransitionFail:
+		return "fail"
+
+	case TransitionExpire:
+		return "expire"
+
+	case TransitionEvict:
+		return "evict"
+
+	case TransitionForget:
+		return "forget"
+
+	case TransitionCancel:
+		return "cancel"
+
+	default:
+		return "unknown"
+	}
+}
+
+// Observer is called on every transition of a recorder entry, with the hash of the entry.
+// It is called with the recorder locked, so it must not call back into the recorder.
+type Observer func(hash string, transition Transition)
+
+// SetObserver sets the observer notified of every entry transition, for debugging purposes.
+// A nil observer removes the current one.
+func (h *SendRecorder) SetObserver(observer Observer) {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	h.observer = observer
+}
+
+// observeUnsafe notifies the observer, if any, of the given transition. A panicking observer does not affect the
+// recorder.
+func (h *SendRecorder) observeUnsafe(hash string, transition Transition) {
+	if h.observer == nil {
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			logrus.WithField("transition", transition).Errorf("Send recorder observer panicked: %v", r)
+		}
+	}()
+
+	h.observer(hash, transition)
+}
+
+// DecisionHook is called when TryInsertWait decides about a message, with whether an entry was inserted for it, i.e.
+// whether it should be sent, and whether it waited for an identical message being sent first. It is not called when
+// TryInsertWait fails. Unlike the observer, it is called without the recorder locked.
+type DecisionHook func(hash string, inserted, waited bool)
+
+// SetDecisionHook sets the hook notified of every decision of TryInsertWait, for debugging purposes.
+// A nil hook removes the current one.
+func (h *SendRecorder) SetDecisionHook(hook DecisionHook) {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	h.decisionHook = hook
+}
+
+// notifyDecision calls the given decision hook, if any. A panicking hook does not affect the recorder.
+func notifyDecision(hook DecisionHook, hash string, inserted, waited bool) {
+	if hook == nil {
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			logrus.WithField("inserted", inserted).WithField("waited", waited).Errorf("Send recorder decision hook panicked: %v", r)
+		}
+	}()
+
+	hook(hash, inserted, waited)
+}
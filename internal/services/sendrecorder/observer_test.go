@@ -0,0 +1,177 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type observedTransition struct {
+	hash       string
+	transition Transition
+}
+
+func TestSendHasher_Observer(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, 2)
+
+	var transitions []observedTransition
+
+	h.SetObserver(func(hash string, transition Transition) {
+		transitions = append(transitions, observedTransition{hash: hash, transition: transition})
+	})
+
+	// A message which is sent.
+	srID, _, ok := h.TryInsert("a", []string{"to@pm.me"})
+	require.True(t, ok)
+	h.SignalMessageSent("a", srID, "msgID")
+
+	// A message which fails to send.
+	srID, _, ok = h.TryInsert("b", []string{"to@pm.me"})
+	require.True(t, ok)
+	h.RemoveOnFail("b", srID)
+
+	// A message which expires before the next insert.
+	_, _, ok = h.TryInsertTTL("c", []string{"to@pm.me"}, 10*time.Millisecond)
+	require.True(t, ok)
+	time.Sleep(20 * time.Millisecond)
+
+	_, _, ok = h.TryInsert("d", []string{"to@pm.me"})
+	require.True(t, ok)
+
+	// A third entry evicts the oldest one.
+	_, _, ok = h.TryInsert("e", []string{"to@pm.me"})
+	require.True(t, ok)
+
+	require.Equal(t, []observedTransition{
+		{hash: "a", transition: TransitionInsert},
+		{hash: "a", transition: TransitionAck},
+		{hash: "b", transition: TransitionInsert},
+		{hash: "b", transition: TransitionFail},
+		{hash: "c", transition: TransitionInsert},
+		{hash: "c", transition: TransitionExpire},
+		{hash: "d", transition: TransitionInsert},
+		{hash: "e", transition: TransitionInsert},
+		{hash: "a", transition: TransitionEvict},
+	}, transitions)
+
+	// Once removed, the observer is no longer called.
+	h.SetObserver(nil)
+
+	_, _, ok = h.TryInsert("f", []string{"to@pm.me"})
+	require.True(t, ok)
+	require.Len(t, transitions, 9)
+}
+
+func TestSendHasher_ObserverPanics(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	h.SetObserver(func(string, Transition) {
+		panic("observer failure")
+	})
+
+	srID, _, ok := h.TryInsert("a", []string{"to@pm.me"})
+	require.True(t, ok)
+	h.SignalMessageSent("a", srID, "msgID")
+
+	// The recorder is still usable and the message is deduplicated.
+	_, _, ok = h.TryInsert("a", []string{"to@pm.me"})
+	require.False(t, ok)
+
+	msgID, found, err := h.HasEntryWait(context.Background(), "a", time.Now().Add(time.Second), []string{"to@pm.me"})
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "msgID", msgID)
+}
+
+type dedupDecision struct {
+	hash     string
+	inserted bool
+	waited   bool
+}
+
+func TestSendHasher_DecisionHook(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	decisions := make(chan dedupDecision, 10)
+
+	h.SetDecisionHook(func(hash string, inserted, waited bool) {
+		// The hook is called without the recorder locked, so it may call back into it.
+		h.SetMaxInsertAttempts(3)
+
+		decisions <- dedupDecision{hash: hash, inserted: inserted, waited: waited}
+	})
+
+	to := []string{"to@pm.me"}
+	deadline := time.Now().Add(time.Second)
+
+	// A new message is inserted without waiting.
+	srID, ok, err := h.TryInsertWait(context.Background(), "a", to, deadline)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, dedupDecision{hash: "a", inserted: true}, <-decisions)
+
+	// A duplicate waits for the message being sent, then is deduplicated.
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		h.SignalMessageSent("a", srID, "msgID")
+	}()
+
+	_, ok, err = h.TryInsertWait(context.Background(), "a", to, deadline)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, dedupDecision{hash: "a", waited: true}, <-decisions)
+
+	// A duplicate of a message already sent is deduplicated without waiting.
+	_, ok, err = h.TryInsertWait(context.Background(), "a", to, deadline)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, dedupDecision{hash: "a"}, <-decisions)
+
+	// A duplicate of a message which fails to send is inserted again, after waiting.
+	srID, ok, err = h.TryInsertWait(context.Background(), "b", to, deadline)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, dedupDecision{hash: "b", inserted: true}, <-decisions)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		h.RemoveOnFail("b", srID)
+	}()
+
+	_, ok, err = h.TryInsertWait(context.Background(), "b", to, deadline)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, dedupDecision{hash: "b", inserted: true, waited: true}, <-decisions)
+
+	// Failures are not decisions.
+	_, _, err = h.TryInsertWait(context.Background(), "b", to, time.Now().Add(10*time.Millisecond))
+	require.ErrorIs(t, err, ErrSendWaitTimeout)
+	require.Empty(t, decisions)
+
+	// Once removed, the hook is no longer called.
+	h.SetDecisionHook(nil)
+
+	_, ok, err = h.TryInsertWait(context.Background(), "c", to, deadline)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Empty(t, decisions)
+}
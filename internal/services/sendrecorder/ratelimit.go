@@ -0,0 +1,135 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrSendRateLimited is returned when a message cannot be allowed to be sent before the deadline, because too many
+// messages were sent recently.
+var ErrSendRateLimited = errors.New("too many messages sent recently")
+
+// sendLimiter is a token bucket pacing the sends, so that bursts of messages do not trip the API rate limits. It holds
+// up to burst tokens, refilled at rate tokens per second; each send takes one. Tokens are reserved in order, so that
+// the senders waiting for a token get it first come, first served. A non-positive rate disables the limiter.
+type sendLimiter struct {
+	rate  float64
+	burst int
+
+	// tokens is the number of tokens available at last; it is negative when tokens are reserved ahead of time.
+	tokens float64
+	last   time.Time
+
+	lock sync.Mutex
+}
+
+// set configures the limiter, which starts with a full bucket.
+func (l *sendLimiter) set(rate float64, burst int, now time.Time) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	l.rate, l.burst = rate, burst
+	l.tokens, l.last = float64(burst), now
+}
+
+// reserve takes a token and returns how long to wait before using it, or false if it would be available only after
+// the deadline, in which case no token is taken.
+func (l *sendLimiter) reserve(now, deadline time.Time) (time.Duration, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.rate <= 0 {
+		return 0, true
+	}
+
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		l.last = now
+	}
+
+	if l.tokens > float64(l.burst) {
+		l.tokens = float64(l.burst)
+	}
+
+	var delay time.Duration
+
+	if l.tokens < 1 {
+		delay = time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+	}
+
+	if now.Add(delay).After(deadline) {
+		return 0, false
+	}
+
+	l.tokens--
+
+	return delay, true
+}
+
+// cancel gives back a token which was reserved but not used.
+func (l *sendLimiter) cancel() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.rate > 0 {
+		l.tokens++
+	}
+}
+
+// SetSendRateLimit paces the sends to rate messages per second on average, allowing bursts of up to burst messages.
+// Once the limit is reached, TryInsertWait and WaitSendAllowed wait for a message to be allowed to be sent. A
+// non-positive rate, the default, disables the limit.
+func (h *SendRecorder) SetSendRateLimit(rate float64, burst int) {
+	h.limiter.set(rate, burst, time.Now())
+}
+
+// WaitSendAllowed waits until the send rate limit allows a message to be sent. It returns ErrSendRateLimited if it
+// would have to wait past the deadline, or the context error if the context is done first. TryInsertWait calls it for
+// the messages it inserts; it should be called for the messages sent without going through the recorder.
+func (h *SendRecorder) WaitSendAllowed(ctx context.Context, deadline time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay, ok := h.limiter.reserve(time.Now(), deadline)
+	if !ok {
+		return ErrSendRateLimited
+	} else if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+
+	case <-ctx.Done():
+		h.limiter.cancel()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendHasher_RateLimit_Paced(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+	h.SetSendRateLimit(20, 2)
+
+	start := time.Now()
+
+	for i := 0; i < 6; i++ {
+		_, ok, err := h.TryInsertWait(context.Background(), fmt.Sprint(i), nil, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		// The first sends are a burst; the next ones are allowed one every 50ms.
+		if i < 2 {
+			require.Less(t, time.Since(start), 40*time.Millisecond)
+		}
+	}
+
+	elapsed := time.Since(start)
+	require.GreaterOrEqual(t, elapsed, 190*time.Millisecond)
+	require.Less(t, elapsed, time.Second)
+}
+
+func TestSendHasher_RateLimit_DuplicatesAreNotLimited(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+	h.SetSendRateLimit(1, 1)
+
+	srID, ok, err := h.TryInsertWait(context.Background(), "a", nil, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	h.SignalMessageSent("a", srID, "msgID")
+
+	// Duplicates are not sent, so they do not wait for the rate limit.
+	start := time.Now()
+
+	for i := 0; i < 3; i++ {
+		_, ok, err := h.TryInsertWait(context.Background(), "a", nil, time.Now().Add(time.Second))
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestSendHasher_RateLimit_Deadline(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+	h.SetSendRateLimit(1, 1)
+
+	_, ok, err := h.TryInsertWait(context.Background(), "a", nil, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// The next send is only allowed in a second, after the deadline: it fails without waiting.
+	start := time.Now()
+
+	_, _, err = h.TryInsertWait(context.Background(), "b", nil, time.Now().Add(100*time.Millisecond))
+	require.ErrorIs(t, err, ErrSendRateLimited)
+	require.Less(t, time.Since(start), 100*time.Millisecond)
+
+	// The entry of the message which was not allowed to be sent is removed.
+	_, found, err := h.HasEntryWait(context.Background(), "b", time.Now().Add(time.Second), nil)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// With a later deadline, the send waits for its turn.
+	_, ok, err = h.TryInsertWait(context.Background(), "b", nil, time.Now().Add(2*time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
+
+func TestSendHasher_RateLimit_CancelledWait(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+	h.SetSendRateLimit(5, 1)
+
+	require.NoError(t, h.WaitSendAllowed(context.Background(), time.Now().Add(time.Second)))
+
+	// A sender giving up while waiting gives its turn back.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, h.WaitSendAllowed(ctx, time.Now().Add(time.Second)), context.DeadlineExceeded)
+
+	start := time.Now()
+
+	require.NoError(t, h.WaitSendAllowed(context.Background(), time.Now().Add(time.Second)))
+	require.Less(t, time.Since(start), 250*time.Millisecond)
+}
+
+func TestSendHasher_RateLimit_DisabledByDefault(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	start := time.Now()
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, h.WaitSendAllowed(context.Background(), time.Now()))
+	}
+
+	require.Less(t, time.Since(start), 100*time.Millisecond)
+
+	// A non-positive rate disables the limit again.
+	h.SetSendRateLimit(1, 1)
+	h.SetSendRateLimit(0, 0)
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, h.WaitSendAllowed(context.Background(), time.Now()))
+	}
+}
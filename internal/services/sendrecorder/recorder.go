@@ -18,13 +18,15 @@
 package sendrecorder
 
 import (
+	"container/heap"
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/ProtonMail/gluon/rfc822"
 	"github.com/bradenaw/juniper/xslices"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/slices"
@@ -32,30 +34,379 @@ import (
 
 const SendEntryExpiry = 30 * time.Minute
 
+// SendMaxEntries is the default maximum number of entries kept in the send recorder.
+const SendMaxEntries = 1000
+
+// SendMaxInsertAttempts is the default number of times an insert is attempted when the messages it waits on keep
+// failing to send.
+const SendMaxInsertAttempts = 10
+
+// SendReinsertMaxJitter is the maximum random delay before inserting a message again after the identical message it
+// waited on failed to send.
+const SendReinsertMaxJitter = 20 * time.Millisecond
+
+// ErrTooManyInsertAttempts is returned when an insert gives up because the messages it waited on kept failing to send.
+var ErrTooManyInsertAttempts = errors.New("too many attempts to insert message")
+
+// ErrSendWaitTimeout is returned when waiting for a prior identical message, which is still being sent, times out.
+// It is distinct from the error of the caller's context, which is returned as is if the context is done first.
+var ErrSendWaitTimeout = errors.New("timed out waiting for a prior identical send")
+
+// ErrPriorSendInProgress is returned, if enabled with SetPriorSendInProgressError, when an insert times out waiting for
+// a prior identical message which is still being sent. The prior send may still succeed, so the caller should ask
+// the client to try again later rather than report a failure.
+var ErrPriorSendInProgress = errors.New("a prior identical send is still in progress")
+
+// ErrSendCancelled is returned by CommitSend when the send was cancelled with CancelSend before it was committed, in
+// which case the message must not be sent.
+var ErrSendCancelled = errors.New("send was cancelled")
+
 type ID uint64
 
+// HashErrorPolicy defines how the recorder deals with messages whose hash cannot be computed.
+type HashErrorPolicy int
+
+const (
+	// HashErrorPolicyFail returns the hash error, which fails the operation (e.g. the send).
+	HashErrorPolicyFail HashErrorPolicy = iota
+
+	// HashErrorPolicySendWithoutDedup logs the hash error and lets the message through without duplicate protection.
+	HashErrorPolicySendWithoutDedup
+)
+
 type SendRecorder struct {
-	expiry time.Duration
+	expiry          time.Duration
+	maxEntries      int
+	maxAttempts     int
+	hashErrorPolicy HashErrorPolicy
+	hashOptions     hashOptions
+	disabled        bool
+	expiryGrace     time.Duration
+
+	// shadow makes inserts always succeed, only logging the messages which would have been deduplicated.
+	shadow bool
+
+	// hasher, if set, replaces GetMessageHash and the hashing options in HashMessage.
+	hasher Hasher
+
+	// now returns the current time; it is replaced in tests to control expiry.
+	now func() time.Time
+
+	priorSendInProgressError bool
 
 	entries         map[string][]*sendEntry
+	expiries        expiryHeap
 	entriesLock     sync.Mutex
 	cancelIDCounter uint64
+
+	// cancelled holds the IDs of the sends cancelled before they were committed, until their senders learn about it
+	// or their entries would have expired.
+	cancelled map[ID]time.Time
+
+	stats recorderStats
+
+	// limiter paces the sends of the messages inserted by TryInsertWait.
+	limiter sendLimiter
+
+	store        PersistentStore
+	observer     Observer
+	decisionHook DecisionHook
+
+	janitorCancel context.CancelFunc
+	janitorDone   chan struct{}
+	janitorLock   sync.Mutex
 }
 
-func NewSendRecorder(expiry time.Duration) *SendRecorder {
+// Stats holds counters describing the activity of the send recorder since it was created.
+type Stats struct {
+	Inserts      uint64 // Number of entries inserted.
+	DedupHits    uint64 // Number of inserts which found an existing entry for the same message.
+	Waits        uint64 // Number of waits which ended with the awaited message being sent.
+	Reinserts    uint64 // Number of inserts retried because the awaited message failed to send.
+	WaitTimeouts uint64 // Number of waits which timed out.
+}
+
+type recorderStats struct {
+	inserts      atomic.Uint64
+	dedupHits    atomic.Uint64
+	waits        atomic.Uint64
+	reinserts    atomic.Uint64
+	waitTimeouts atomic.Uint64
+}
+
+// Stats returns a snapshot of the recorder counters.
+func (h *SendRecorder) Stats() Stats {
+	return Stats{
+		Inserts:      h.stats.inserts.Load(),
+		DedupHits:    h.stats.dedupHits.Load(),
+		Waits:        h.stats.waits.Load(),
+		Reinserts:    h.stats.reinserts.Load(),
+		WaitTimeouts: h.stats.waitTimeouts.Load(),
+	}
+}
+
+// NewSendRecorder creates a send recorder whose entries expire after the given duration.
+// If maxEntries is positive, the oldest entries are evicted whenever the recorder holds more than maxEntries entries.
+func NewSendRecorder(expiry time.Duration, maxEntries int) *SendRecorder {
 	return &SendRecorder{
-		expiry:  expiry,
-		entries: make(map[string][]*sendEntry),
+		expiry:      expiry,
+		maxEntries:  maxEntries,
+		maxAttempts: SendMaxInsertAttempts,
+		entries:     make(map[string][]*sendEntry),
+		now:         time.Now,
 	}
 }
 
+// NewSendRecorderWithHasher is like NewSendRecorder, but messages are hashed with the given hasher rather than with
+// GetMessageHash, e.g. to fit clients with unusual MIME structures or to hash huge messages more cheaply.
+// The hashing options set on the recorder, such as the normalization profile, do not apply to the given hasher.
+func NewSendRecorderWithHasher(expiry time.Duration, maxEntries int, hasher Hasher) *SendRecorder {
+	h := NewSendRecorder(expiry, maxEntries)
+	h.hasher = hasher
+
+	return h
+}
+
+// NewShadowSendRecorder is like NewSendRecorder, but the recorder never deduplicates messages: inserts always succeed,
+// as if no identical message had been sent, and the messages which would have been deduplicated are logged instead.
+// It is meant to diagnose suspected false positives in the field without blocking any send.
+func NewShadowSendRecorder(expiry time.Duration, maxEntries int) *SendRecorder {
+	h := NewSendRecorder(expiry, maxEntries)
+	h.shadow = true
+
+	return h
+}
+
+// StartJanitor starts a goroutine which removes expired entries every interval, so that they do not linger
+// in a recorder which is no longer accessed. The janitor stops when the context is cancelled or Close is called.
+// Starting a janitor while another one is running stops the previous one first.
+func (h *SendRecorder) StartJanitor(ctx context.Context, interval time.Duration) {
+	h.janitorLock.Lock()
+	defer h.janitorLock.Unlock()
+
+	h.stopJanitorUnsafe()
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	h.janitorCancel = cancel
+	h.janitorDone = done
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-ticker.C:
+				h.removeExpired(ctx)
+			}
+		}
+	}()
+}
+
+// Close stops the janitor, if any, and waits for it to exit.
+func (h *SendRecorder) Close() {
+	h.janitorLock.Lock()
+	defer h.janitorLock.Unlock()
+
+	h.stopJanitorUnsafe()
+}
+
+func (h *SendRecorder) stopJanitorUnsafe() {
+	if h.janitorCancel == nil {
+		return
+	}
+
+	h.janitorCancel()
+	<-h.janitorDone
+
+	h.janitorCancel = nil
+	h.janitorDone = nil
+}
+
+// SetMaxInsertAttempts sets how many times TryInsertWait attempts to insert a message when the identical messages
+// it waits on keep failing to send. A non-positive value selects SendMaxInsertAttempts.
+func (h *SendRecorder) SetMaxInsertAttempts(maxAttempts int) {
+	if maxAttempts <= 0 {
+		maxAttempts = SendMaxInsertAttempts
+	}
+
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	h.maxAttempts = maxAttempts
+}
+
+// SetExpiryGrace sets a grace period during which entries are kept after their expiry, so that clock jitter or a
+// suspend/resume cycle does not evict an entry early on one lookup and keep it on the next. Negative values are treated
+// as zero.
+func (h *SendRecorder) SetExpiryGrace(grace time.Duration) {
+	if grace < 0 {
+		grace = 0
+	}
+
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	h.expiryGrace = grace
+}
+
+// SetEnabled sets whether the recorder detects duplicate messages. When disabled, HashMessage returns an empty hash
+// for every message, so that the callers handle all messages without going through the recorder.
+func (h *SendRecorder) SetEnabled(enabled bool) {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	h.disabled = !enabled
+}
+
+// SetPriorSendInProgressError sets whether an insert which times out waiting for a prior identical message still being
+// sent fails with ErrPriorSendInProgress rather than with the generic wait error.
+func (h *SendRecorder) SetPriorSendInProgressError(enabled bool) {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	h.priorSendInProgressError = enabled
+}
+
+// SetHashErrorPolicy sets how messages whose hash cannot be computed are handled by HashMessage.
+func (h *SendRecorder) SetHashErrorPolicy(policy HashErrorPolicy) {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	h.hashErrorPolicy = policy
+}
+
+// SetIgnoredHeaders sets glob patterns (as understood by path.Match, matched case-insensitively) of header names which
+// HashMessage leaves out of the hash, so that installation-specific headers which vary between sends do not defeat
+// deduplication. Only headers which are otherwise part of the hash are affected.
+func (h *SendRecorder) SetIgnoredHeaders(patterns []string) error {
+	ignoredHeaders, err := parseIgnoredHeaders(patterns)
+	if err != nil {
+		return err
+	}
+
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	h.hashOptions.ignoredHeaders = ignoredHeaders
+
+	return nil
+}
+
+// SetUseMessageID sets whether HashMessage gives precedence to the Message-ID header, as GetMessageHashWithMessageID
+// does. This makes clients which retry a send with the same Message-ID always deduplicate, even if they re-encode the
+// message differently.
+func (h *SendRecorder) SetUseMessageID(useMessageID bool) {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	h.hashOptions.useMessageID = useMessageID
+}
+
+// SetHashSignatures sets whether HashMessage includes detached signature parts, such as the application/pgp-signature
+// part of a multipart/signed message. They are excluded by default, as they differ on every send of the same content.
+func (h *SendRecorder) SetHashSignatures(includeSignatures bool) {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	h.hashOptions.includeSignatures = includeSignatures
+}
+
+// SetHashStructure sets whether HashMessage includes the number of MIME parts and the length of the message, as a cheap
+// guard against structurally different messages sharing their hashed headers and bodies. It is disabled by default, so
+// that hashes are unchanged, and makes messages which only differ in line endings or transfer encodings hash differently.
+func (h *SendRecorder) SetHashStructure(includeStructure bool) {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	h.hashOptions.includeStructure = includeStructure
+}
+
+// SetHashDateBucket sets the window, typically the entry expiry, in which the Date header of messages is rounded and
+// made part of the hash by HashMessage, so that the same content intentionally sent again later does not hash the same
+// as the prior send. Retries of a send keep their Date header, so they are still deduplicated, unless the window is
+// crossed between the two. It is disabled by default, or if the bucket is not positive.
+func (h *SendRecorder) SetHashDateBucket(bucket time.Duration) {
+	if bucket < 0 {
+		bucket = 0
+	}
+
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	h.hashOptions.dateBucket = bucket
+}
+
+// SetNormalizationProfile sets the profile selecting which normalizations HashMessage applies before hashing, e.g.
+// "strict", "lenient", "thunderbird" or "apple-mail", to fit how the client in use serializes messages. An empty
+// profile selects the default, lenient profile.
+func (h *SendRecorder) SetNormalizationProfile(profile string) error {
+	normalizationProfile, err := parseNormalizationProfile(profile)
+	if err != nil {
+		return err
+	}
+
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	h.hashOptions.profile = normalizationProfile
+
+	return nil
+}
+
+// HashMessage returns the hash of the given message, as GetMessageHash does, honouring the hashing options set on the
+// recorder, or as the hasher the recorder was created with does.
+// If the hash cannot be computed and the policy is HashErrorPolicySendWithoutDedup, the error is logged and an empty
+// hash is returned; the message should then be handled without going through the recorder.
+// If the recorder is disabled, an empty hash is returned without hashing the message.
+func (h *SendRecorder) HashMessage(b []byte) (string, error) {
+	h.entriesLock.Lock()
+	policy, opts, disabled := h.hashErrorPolicy, h.hashOptions, h.disabled
+	h.entriesLock.Unlock()
+
+	if disabled {
+		return "", nil
+	}
+
+	hasher := h.hasher
+	if hasher == nil {
+		hasher = func(b []byte) (string, error) { return getMessageHash(b, opts) }
+	}
+
+	hash, err := hasher(b)
+	if err == nil {
+		return hash, nil
+	}
+
+	if policy != HashErrorPolicySendWithoutDedup {
+		return "", err
+	}
+
+	logrus.WithError(err).Warn("Failed to compute message hash, proceeding without duplicate protection")
+
+	return "", nil
+}
+
 type sendEntry struct {
+	hash         string
 	srID         ID
 	msgID        string
 	toList       []string
 	exp          time.Time
 	waitCh       chan struct{}
 	waitChClosed bool
+	waiters      int
+	heapIndex    int
+
+	// committed is set once the message is being handed over to the API; the send can no longer be cancelled.
+	committed bool
 }
 
 func (s *sendEntry) closeWaitChannel() {
@@ -74,114 +425,371 @@ func (h *SendRecorder) TryInsertWait(
 	toList []string,
 	deadline time.Time,
 ) (ID, bool, error) {
-	// If we successfully inserted the hash, we can return true.
-	srID, waitCh, ok := h.TryInsert(hash, toList)
-	if ok {
-		return srID, true, nil
+	return h.TryInsertWaitTTL(ctx, hash, toList, deadline, h.expiry)
+}
+
+// TryInsertWaitTTL is like TryInsertWait, but an inserted entry expires after the given ttl instead of the
+// recorder's default expiry. A non-positive ttl selects the default expiry.
+func (h *SendRecorder) TryInsertWaitTTL(
+	ctx context.Context,
+	hash string,
+	toList []string,
+	deadline time.Time,
+	ttl time.Duration,
+) (ID, bool, error) {
+	srID, _, ok, err := h.tryInsertWait(ctx, hash, toList, deadline, ttl)
+
+	return srID, ok, err
+}
+
+// TryInsertWaitGetID is like TryInsertWait, but if the message is a duplicate of one which was already sent,
+// it also returns the message ID of the original send.
+func (h *SendRecorder) TryInsertWaitGetID(
+	ctx context.Context,
+	hash string,
+	toList []string,
+	deadline time.Time,
+) (ID, string, bool, error) {
+	return h.tryInsertWait(ctx, hash, toList, deadline, h.expiry)
+}
+
+func (h *SendRecorder) tryInsertWait(
+	ctx context.Context,
+	hash string,
+	toList []string,
+	deadline time.Time,
+	ttl time.Duration,
+) (ID, string, bool, error) {
+	h.entriesLock.Lock()
+	maxAttempts, priorSendInProgressError, decisionHook := h.maxAttempts, h.priorSendInProgressError, h.decisionHook
+	h.entriesLock.Unlock()
+
+	// waited is whether an identical message was still being sent, so that we had to wait for it.
+	var waited bool
+
+	for attempt := 1; ; attempt++ {
+		// If we successfully inserted the hash, we can return true.
+		srID, waitCh, ok, err := h.tryInsert(ctx, hash, toList, ttl)
+		if err != nil {
+			return 0, "", false, fmt.Errorf("failed to insert message: %w", err)
+		} else if ok {
+			// The message is about to be sent; wait for the rate limit to allow it, keeping identical messages waiting.
+			if err := h.WaitSendAllowed(ctx, deadline); err != nil {
+				h.RemoveOnFail(hash, srID)
+				return 0, "", false, fmt.Errorf("failed to wait for send to be allowed: %w", err)
+			}
+
+			notifyDecision(decisionHook, hash, true, waited)
+			return srID, "", true, nil
+		}
+
+		select {
+		case <-waitCh:
+		default:
+			waited = true
+		}
+
+		// A message with this hash is already being sent; wait for it.
+		messageID, wasSent, err := h.wait(ctx, hash, waitCh, srID, deadline)
+		if err != nil {
+			// Only the deadline given to us is a timeout on the prior send, not the cancellation of our context.
+			if priorSendInProgressError && errors.Is(err, ErrSendWaitTimeout) {
+				return 0, "", false, ErrPriorSendInProgress
+			}
+
+			return 0, "", false, fmt.Errorf("failed to wait for message to be sent: %w", err)
+		}
+
+		if wasSent {
+			notifyDecision(decisionHook, hash, false, waited)
+			return srID, messageID, false, nil
+		}
+
+		// The message failed to send, try to insert it again unless we already tried too many times.
+		if attempt >= maxAttempts {
+			return 0, "", false, fmt.Errorf("%w: gave up after %v attempts", ErrTooManyInsertAttempts, attempt)
+		}
+
+		// All the waiters of the failed message wake up at once; spread their inserts so that they do not stampede.
+		if err := sleepReinsertJitter(ctx, deadline); err != nil {
+			return 0, "", false, fmt.Errorf("failed to insert message: %w", err)
+		}
+
+		h.stats.reinserts.Add(1)
 	}
+}
 
-	// A message with this hash is already being sent; wait for it.
-	_, wasSent, err := h.wait(ctx, hash, waitCh, srID, deadline)
-	if err != nil {
-		return 0, false, fmt.Errorf("failed to wait for message to be sent: %w", err)
+// sleepReinsertJitter sleeps for a random duration of up to SendReinsertMaxJitter, bounded to a small fraction of the
+// time left until the deadline. It returns the context error if the context is done first.
+func sleepReinsertJitter(ctx context.Context, deadline time.Time) error {
+	maxJitter := SendReinsertMaxJitter
+	if left := time.Until(deadline) / 10; left < maxJitter {
+		maxJitter = left
 	}
 
-	// If the message failed to send, try to insert it again.
-	if !wasSent {
-		return h.TryInsertWait(ctx, hash, toList, deadline)
+	if maxJitter <= 0 {
+		return ctx.Err()
 	}
 
-	return srID, false, nil
+	timer := time.NewTimer(time.Duration(rand.Int63n(int64(maxJitter)))) //nolint:gosec
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // HasEntryWait returns whether the given message already exists in the send recorder.
 // If it does, it waits for its ID to be known, then returns it and true.
 // If no entry exists, or it times out while waiting for its ID to be known, it returns false.
+// If the awaited messages keep failing to send, it gives up and returns false after SetMaxInsertAttempts waits.
 func (h *SendRecorder) HasEntryWait(ctx context.Context,
 	hash string,
 	deadline time.Time,
 	toList []string,
 ) (string, bool, error) {
-	srID, waitCh, found := h.getEntryWaitInfo(hash, toList)
-	if !found {
-		return "", false, nil
+	h.entriesLock.Lock()
+	maxAttempts := h.maxAttempts
+	h.entriesLock.Unlock()
+
+	for attempt := 0; attempt < maxAttempts && time.Now().Before(deadline); attempt++ {
+		srID, waitCh, found, err := h.getEntryWaitInfo(ctx, hash, toList)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to look up message: %w", err)
+		} else if !found {
+			return "", false, nil
+		}
+
+		messageID, wasSent, err := h.wait(ctx, hash, waitCh, srID, deadline)
+		if errors.Is(err, ErrSendWaitTimeout) {
+			return "", false, nil
+		} else if err != nil {
+			return "", false, fmt.Errorf("failed to wait for message to be sent: %w", err)
+		}
+
+		if wasSent {
+			return messageID, true, nil
+		}
 	}
 
-	messageID, wasSent, err := h.wait(ctx, hash, waitCh, srID, deadline)
-	if errors.Is(err, context.DeadlineExceeded) {
-		return "", false, nil
-	} else if err != nil {
-		return "", false, fmt.Errorf("failed to wait for message to be sent: %w", err)
+	return "", false, nil
+}
+
+func (h *SendRecorder) removeExpired(ctx context.Context) {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	_ = h.removeExpiredUnsafe(ctx)
+}
+
+// removeExpiredUnsafe removes the expired entries, popping them off the expiry heap.
+// It stops early and returns the context error if the context is cancelled.
+func (h *SendRecorder) removeExpiredUnsafe(ctx context.Context) error {
+	now := h.now()
+
+	for entry := h.expiries.peek(); entry != nil && h.isExpiredUnsafe(entry.exp, now); entry = h.expiries.peek() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		h.deleteEntryUnsafe(entry)
+		h.observeUnsafe(entry.hash, TransitionExpire)
 	}
 
-	if wasSent {
-		return messageID, true, nil
+	for srID, exp := range h.cancelled {
+		if h.isExpiredUnsafe(exp, now) {
+			delete(h.cancelled, srID)
+		}
 	}
 
-	return h.HasEntryWait(ctx, hash, deadline, toList)
+	return nil
+}
+
+// isExpiredUnsafe returns whether an entry with the given expiry is expired at the given time, once the expiry grace has
+// elapsed. All expiry checks go through it, so that lookups and inserts agree on which entries are still valid.
+func (h *SendRecorder) isExpiredUnsafe(exp, now time.Time) bool {
+	return exp.Add(h.expiryGrace).Before(now)
+}
+
+// deleteEntryUnsafe removes the given entry from both the entry map and the expiry heap.
+func (h *SendRecorder) deleteEntryUnsafe(entry *sendEntry) {
+	h.expiries.remove(entry)
+	h.unpersistEntryUnsafe(entry)
+
+	remaining := xslices.Filter(h.entries[entry.hash], func(e *sendEntry) bool { return e != entry })
+	if len(remaining) != 0 {
+		h.entries[entry.hash] = remaining
+	} else {
+		delete(h.entries, entry.hash)
+	}
 }
 
-func (h *SendRecorder) removeExpiredUnsafe() {
-	for hash, entry := range h.entries {
-		remaining := xslices.Filter(entry, func(t *sendEntry) bool {
-			return !t.exp.Before(time.Now())
-		})
+// evictOverflowUnsafe evicts the entries closest to expiry until the recorder holds no more than maxEntries entries.
+// Only the entries of sent or expired messages which are not waited on are evicted: dropping the entry of a message
+// which is still being sent would let an identical retry send it again. If there are not enough such entries, the
+// recorder temporarily holds more than maxEntries entries.
+func (h *SendRecorder) evictOverflowUnsafe() {
+	if h.maxEntries <= 0 || len(h.expiries) <= h.maxEntries {
+		return
+	}
+
+	now := h.now()
+
+	var kept []*sendEntry
+
+	for len(h.expiries) > 0 && len(h.expiries)+len(kept) > h.maxEntries {
+		entry := heap.Pop(&h.expiries).(*sendEntry) //nolint:forcetypeassert
+
+		if entry.waiters > 0 || (entry.msgID == "" && !h.isExpiredUnsafe(entry.exp, now)) {
+			kept = append(kept, entry)
+			continue
+		}
+
+		// Wake up anyone who obtained the wait channel but did not start waiting yet; they will find no entry.
+		entry.closeWaitChannel()
+
+		h.deleteEntryUnsafe(entry)
+		h.observeUnsafe(entry.hash, TransitionEvict)
+	}
+
+	for _, entry := range kept {
+		heap.Push(&h.expiries, entry)
+	}
+
+	if len(h.expiries) > h.maxEntries {
+		logrus.WithField("entries", len(h.expiries)).Debug("Send recorder is over capacity with messages still being sent")
+	}
+}
 
-		if len(remaining) == 0 {
-			delete(h.entries, hash)
-		} else {
-			h.entries[hash] = remaining
+// updateWaitersUnsafe adds delta to the number of waiters of the given entry, if it still exists.
+func (h *SendRecorder) updateWaitersUnsafe(hash string, srID ID, delta int) {
+	for _, entry := range h.entries[hash] {
+		if entry.srID == srID {
+			entry.waiters += delta
+			return
 		}
 	}
 }
 
 func (h *SendRecorder) TryInsert(hash string, toList []string) (ID, <-chan struct{}, bool) {
+	return h.TryInsertTTL(hash, toList, h.expiry)
+}
+
+// TryInsertTTL is like TryInsert, but an inserted entry expires after the given ttl instead of the recorder's
+// default expiry. A non-positive ttl selects the default expiry.
+func (h *SendRecorder) TryInsertTTL(hash string, toList []string, ttl time.Duration) (ID, <-chan struct{}, bool) {
+	srID, waitCh, ok, _ := h.tryInsert(context.Background(), hash, toList, ttl)
+
+	return srID, waitCh, ok
+}
+
+// tryInsert is like TryInsertTTL, but it gives up without inserting anything if the context is cancelled.
+func (h *SendRecorder) tryInsert(
+	ctx context.Context,
+	hash string,
+	toList []string,
+	ttl time.Duration,
+) (ID, <-chan struct{}, bool, error) {
+	if ttl <= 0 {
+		ttl = h.expiry
+	}
+
+	if err := ctx.Err(); err != nil {
+		return 0, nil, false, err
+	}
+
 	h.entriesLock.Lock()
 	defer h.entriesLock.Unlock()
 
-	h.removeExpiredUnsafe()
+	if err := h.removeExpiredUnsafe(ctx); err != nil {
+		return 0, nil, false, err
+	}
 
 	entries, ok := h.entries[hash]
 	if ok {
 		for _, entry := range entries {
-			if matchToList(entry.toList, toList) {
-				return entry.srID, entry.waitCh, false
+			if !h.matchEntry(entry, hash, toList) {
+				continue
 			}
+
+			if h.shadow {
+				logrus.WithFields(logrus.Fields{
+					"hash":        hash,
+					"priorSrID":   entry.srID,
+					"priorExpiry": entry.exp,
+					"priorMsgID":  entry.msgID,
+					"priorSent":   entry.msgID != "",
+				}).Warn("Message would have been deduplicated, sending it anyway (shadow mode)")
+
+				break
+			}
+
+			h.stats.dedupHits.Add(1)
+
+			return entry.srID, entry.waitCh, false, nil
 		}
 	}
 
 	cancelID := h.newSendRecorderID()
 	waitCh := make(chan struct{})
 
-	h.entries[hash] = append(entries, &sendEntry{
+	entry := &sendEntry{
+		hash:   hash,
 		srID:   cancelID,
-		exp:    time.Now().Add(h.expiry),
+		exp:    h.now().Add(ttl),
 		toList: toList,
 		waitCh: waitCh,
-	})
+	}
+
+	h.entries[hash] = append(entries, entry)
+	heap.Push(&h.expiries, entry)
+
+	h.stats.inserts.Add(1)
+	h.observeUnsafe(hash, TransitionInsert)
 
-	return cancelID, waitCh, true
+	h.evictOverflowUnsafe()
+
+	return cancelID, waitCh, true, nil
 }
 
-func (h *SendRecorder) getEntryWaitInfo(hash string, toList []string) (ID, <-chan struct{}, bool) {
+func (h *SendRecorder) getEntryWaitInfo(
+	ctx context.Context,
+	hash string,
+	toList []string,
+) (ID, <-chan struct{}, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, nil, false, err
+	}
+
 	h.entriesLock.Lock()
 	defer h.entriesLock.Unlock()
 
-	h.removeExpiredUnsafe()
+	if err := h.removeExpiredUnsafe(ctx); err != nil {
+		return 0, nil, false, err
+	}
 
 	if entries, ok := h.entries[hash]; ok {
 		for _, e := range entries {
-			if matchToList(e.toList, toList) {
-				return e.srID, e.waitCh, true
+			if h.matchEntry(e, hash, toList) {
+				return e.srID, e.waitCh, true, nil
 			}
 		}
 	}
 
-	return 0, nil, false
+	return 0, nil, false, nil
 }
 
 // SignalMessageSent should be called after a message has been successfully sent.
 func (h *SendRecorder) SignalMessageSent(hash string, srID ID, msgID string) {
+	// Messages which could not be hashed were never recorded.
+	if hash == "" {
+		return
+	}
+
 	h.entriesLock.Lock()
 	defer h.entriesLock.Unlock()
 
@@ -191,6 +799,9 @@ func (h *SendRecorder) SignalMessageSent(hash string, srID ID, msgID string) {
 			if entry.srID == srID {
 				entry.msgID = msgID
 				entry.closeWaitChannel()
+				h.persistEntryUnsafe(entry)
+				h.observeUnsafe(hash, TransitionAck)
+
 				return
 			}
 		}
@@ -203,23 +814,137 @@ func (h *SendRecorder) RemoveOnFail(hash string, id ID) {
 	h.entriesLock.Lock()
 	defer h.entriesLock.Unlock()
 
+	delete(h.cancelled, id)
+
 	entries, ok := h.entries[hash]
 	if !ok {
 		return
 	}
 
-	for idx, entry := range entries {
+	for _, entry := range entries {
 		if entry.srID == id && entry.msgID == "" {
 			entry.closeWaitChannel()
+			h.deleteEntryUnsafe(entry)
+			h.observeUnsafe(hash, TransitionFail)
+		}
+	}
+}
+
+// Forget removes the entries of the given hash, whatever their recipients, for manual recovery of stuck sends, e.g.
+// when the outcome of a send was never recorded because of a crash. The messages still being sent are considered
+// failed: anyone waiting for them is released to retry. It returns whether there were entries for the hash.
+func (h *SendRecorder) Forget(hash string) bool {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	entries, ok := h.entries[hash]
+	if !ok {
+		return false
+	}
+
+	for _, entry := range slices.Clone(entries) {
+		entry.closeWaitChannel()
+		h.deleteEntryUnsafe(entry)
+		h.observeUnsafe(hash, TransitionForget)
+	}
+
+	return true
+}
+
+// CancelSend cancels the given send of the given hash if it was not committed yet, e.g. because the client gave up on
+// it, removing its entry so that identical retries do not wait for it. It returns whether the send was cancelled.
+//
+// It is safe to call at any point of a send, and coordinates with the sender through CommitSend, which the sender
+// calls right before handing the message over to the API:
+//   - if CancelSend comes first, CommitSend returns ErrSendCancelled and the message must not be sent, so that it is
+//     not sent twice if the client retries;
+//   - if CommitSend comes first, the send is left alone: CancelSend does not cancel it, and identical retries wait for
+//     its outcome as usual.
+func (h *SendRecorder) CancelSend(hash string, srID ID) bool {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	var cancelled bool
+
+	for _, entry := range slices.Clone(h.entries[hash]) {
+		if entry.srID != srID || entry.msgID != "" || entry.committed {
+			continue
+		}
+
+		if h.cancelled == nil {
+			h.cancelled = make(map[ID]time.Time)
+		}
 
-			remaining := xslices.Remove(entries, idx, 1)
-			if len(remaining) != 0 {
-				h.entries[hash] = remaining
-			} else {
-				delete(h.entries, hash)
+		h.cancelled[entry.srID] = entry.exp
+
+		entry.closeWaitChannel()
+		h.deleteEntryUnsafe(entry)
+		h.observeUnsafe(hash, TransitionCancel)
+
+		cancelled = true
+	}
+
+	return cancelled
+}
+
+// CommitSend must be called by the sender right before handing the message over to the API. It returns
+// ErrSendCancelled if the send was cancelled with CancelSend, in which case the message must not be sent; otherwise,
+// the send can no longer be cancelled. See CancelSend for the ordering guarantees.
+func (h *SendRecorder) CommitSend(hash string, srID ID) error {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	if _, ok := h.cancelled[srID]; ok {
+		delete(h.cancelled, srID)
+		return ErrSendCancelled
+	}
+
+	for _, entry := range h.entries[hash] {
+		if entry.srID == srID {
+			entry.committed = true
+		}
+	}
+
+	return nil
+}
+
+// WaitAllInFlight waits for the messages being sent, i.e. inserted but neither signaled as sent nor removed on failure,
+// to complete. It returns 0 once none are in flight, or the number of messages still in flight if the context is done
+// first. Messages which could not be hashed are not recorded, and thus not waited for.
+func (h *SendRecorder) WaitAllInFlight(ctx context.Context) int {
+	for {
+		waitChs := h.getInFlightWaitChannels()
+		if len(waitChs) == 0 {
+			return 0
+		}
+
+		for _, waitCh := range waitChs {
+			select {
+			case <-waitCh:
+
+			case <-ctx.Done():
+				return len(h.getInFlightWaitChannels())
+			}
+		}
+	}
+}
+
+// getInFlightWaitChannels returns the wait channels of the entries of the messages being sent.
+func (h *SendRecorder) getInFlightWaitChannels() []<-chan struct{} {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	var waitChs []<-chan struct{}
+
+	for _, entries := range h.entries {
+		for _, entry := range entries {
+			if !entry.waitChClosed {
+				waitChs = append(waitChs, entry.waitCh)
 			}
 		}
 	}
+
+	return waitChs
 }
 
 func (h *SendRecorder) wait(
@@ -229,12 +954,30 @@ func (h *SendRecorder) wait(
 	srID ID,
 	deadline time.Time,
 ) (string, bool, error) {
-	ctx, cancel := context.WithDeadline(ctx, deadline)
+	waitCtx, cancel := context.WithDeadline(ctx, deadline)
 	defer cancel()
 
+	// Register as a waiter so that the entry is not evicted while we wait on it.
+	safeUpdateWaiters := func(delta int) {
+		h.entriesLock.Lock()
+		defer h.entriesLock.Unlock()
+
+		h.updateWaitersUnsafe(hash, srID, delta)
+	}
+
+	safeUpdateWaiters(1)
+	defer safeUpdateWaiters(-1)
+
 	select {
-	case <-ctx.Done():
-		return "", false, ctx.Err()
+	case <-waitCtx.Done():
+		// The caller's context being done is not a timeout on the prior send.
+		if err := ctx.Err(); err != nil {
+			return "", false, err
+		}
+
+		h.stats.waitTimeouts.Add(1)
+
+		return "", false, ErrSendWaitTimeout
 
 	case <-waitCh:
 		// ...
@@ -246,6 +989,7 @@ func (h *SendRecorder) wait(
 	if entry, ok := h.entries[hash]; ok {
 		for _, e := range entry {
 			if e.srID == srID {
+				h.stats.waits.Add(1)
 				return e.msgID, true, nil
 			}
 		}
@@ -259,15 +1003,15 @@ func (h *SendRecorder) newSendRecorderID() ID {
 	return ID(h.cancelIDCounter)
 }
 
-// GetMessageHash returns the hash of the given message.
-// This takes into account:
-// - the Subject header,
-// - the From/To/Cc headers,
-// - the Content-Type header of each (leaf) part,
-// - the Content-Disposition header of each (leaf) part,
-// - the (decoded) body of each part.
-func GetMessageHash(b []byte) (string, error) {
-	return rfc822.GetMessageHash(b)
+// matchEntry returns whether the entry recorded under the given hash is for the given recipients. Entries whose hash was
+// computed from an idempotency key match any recipients; only the recorder's own hashing computes such hashes, so the
+// hashes of a custom hasher are always matched on their recipients, whatever they look like.
+func (h *SendRecorder) matchEntry(entry *sendEntry, hash string, toList []string) bool {
+	if h.hasher == nil && isIdempotencyHash(hash) {
+		return true
+	}
+
+	return matchToList(entry.toList, toList)
 }
 
 func matchToList(a, b []string) bool {
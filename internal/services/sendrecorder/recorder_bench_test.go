@@ -0,0 +1,186 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime/quotedprintable"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/bradenaw/juniper/xslices"
+)
+
+// sweepExpiredUnsafe is the full-map expiry sweep which the expiry heap replaced, kept here for comparison.
+func (h *SendRecorder) sweepExpiredUnsafe() {
+	for hash, entry := range h.entries {
+		remaining := xslices.Filter(entry, func(t *sendEntry) bool {
+			return !t.exp.Before(time.Now())
+		})
+
+		if len(remaining) == 0 {
+			delete(h.entries, hash)
+		} else {
+			h.entries[hash] = remaining
+		}
+	}
+}
+
+func newBenchmarkRecorder(n int) *SendRecorder {
+	h := NewSendRecorder(SendEntryExpiry, 0)
+
+	for idx := 0; idx < n; idx++ {
+		h.TryInsert(fmt.Sprintf("hash-%d", idx), []string{"someone@example.com"})
+	}
+
+	return h
+}
+
+func BenchmarkSendRecorder_RemoveExpired(b *testing.B) {
+	for _, n := range []int{10_000, 100_000} {
+		h := newBenchmarkRecorder(n)
+
+		b.Run(fmt.Sprintf("sweep/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				h.sweepExpiredUnsafe()
+			}
+		})
+
+		b.Run(fmt.Sprintf("heap/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = h.removeExpiredUnsafe(context.Background())
+			}
+		})
+	}
+}
+
+// normalizeBody applies the body normalizations to the given (decoded) body, as the bodyNormalizer streaming them
+// replaced, kept here for comparison.
+func (norm normalizations) normalizeBody(body []byte) []byte {
+	if norm.lineEndings {
+		body = bytes.ReplaceAll(body, []byte{'\r'}, nil)
+	}
+
+	if norm.trailingSpace {
+		lines := bytes.Split(body, []byte{'\n'})
+
+		for idx, line := range lines {
+			lines[idx] = bytes.TrimRight(line, " \t")
+		}
+
+		body = bytes.Join(lines, []byte{'\n'})
+	}
+
+	if norm.trimBody {
+		body = bytes.TrimSpace(body)
+	}
+
+	return body
+}
+
+// bufferedHashBody is the hashBody which decoded and normalized the whole body in memory, kept here for comparison.
+func bufferedHashBody(writer io.Writer, body []byte, mimeType rfc822.MIMEType, encoding string, norm normalizations) error {
+	if (mimeType != rfc822.TextHTML && mimeType != rfc822.TextPlain) || !norm.transferEncoding {
+		_, err := writer.Write(norm.normalizeBody(body))
+
+		return err
+	}
+
+	var decoded []byte
+
+	switch strings.ToLower(encoding) {
+	case "quoted-printable":
+		d, err := io.ReadAll(quotedprintable.NewReader(bytes.NewReader(body)))
+		if err != nil {
+			return err
+		}
+
+		decoded = d
+
+	case "base64":
+		d, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, bytes.NewReader(body)))
+		if err != nil {
+			return err
+		}
+
+		decoded = d
+
+	default:
+		decoded = body
+	}
+
+	_, err := writer.Write(norm.normalizeBody(decoded))
+
+	return err
+}
+
+// newBenchmarkAttachment returns a base64 encoded body of the given decoded size, wrapped in 76 character lines.
+func newBenchmarkAttachment(size int) []byte {
+	encoded := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte("attachment data "), size/16))
+
+	var body bytes.Buffer
+
+	for len(encoded) > 76 {
+		body.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+
+	body.WriteString(encoded + "\r\n")
+
+	return body.Bytes()
+}
+
+func BenchmarkHashBody_LargeAttachment(b *testing.B) {
+	body := newBenchmarkAttachment(50 << 20)
+	norm := NormalizationProfileLenient.normalizations()
+
+	for _, tt := range []struct {
+		name     string
+		mimeType rfc822.MIMEType
+	}{
+		{name: "attachment", mimeType: "application/octet-stream"},
+		{name: "text", mimeType: rfc822.TextPlain},
+	} {
+		b.Run("buffered/"+tt.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if err := bufferedHashBody(sha256.New(), body, tt.mimeType, "base64", norm); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run("streamed/"+tt.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if err := hashBody(sha256.New(), body, tt.mimeType, "base64", norm); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
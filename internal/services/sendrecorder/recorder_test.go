@@ -18,15 +18,19 @@
 package sendrecorder
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/require"
 )
 
 func TestSendHasher_Insert(t *testing.T) {
-	h := NewSendRecorder(SendEntryExpiry)
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	srdID1, hash1, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
@@ -52,7 +56,7 @@ func TestSendHasher_Insert(t *testing.T) {
 }
 
 func TestSendHasher_Insert_Expired(t *testing.T) {
-	h := NewSendRecorder(time.Second)
+	h := NewSendRecorder(time.Second, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	srID1, hash1, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
@@ -79,7 +83,7 @@ func TestSendHasher_Insert_Expired(t *testing.T) {
 }
 
 func TestSendHasher_Insert_DifferentToList(t *testing.T) {
-	h := NewSendRecorder(time.Second)
+	h := NewSendRecorder(time.Second, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	srID1, hash1, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second), []string{"abc", "def"}...)
@@ -101,7 +105,7 @@ func TestSendHasher_Insert_DifferentToList(t *testing.T) {
 }
 
 func TestSendHasher_Wait_SendSuccess(t *testing.T) {
-	h := NewSendRecorder(SendEntryExpiry)
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	srID1, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
@@ -123,7 +127,7 @@ func TestSendHasher_Wait_SendSuccess(t *testing.T) {
 }
 
 func TestSendHasher_Wait_SendFail(t *testing.T) {
-	h := NewSendRecorder(SendEntryExpiry)
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	srID1, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
@@ -148,7 +152,7 @@ func TestSendHasher_Wait_SendFail(t *testing.T) {
 }
 
 func TestSendHasher_Wait_Timeout(t *testing.T) {
-	h := NewSendRecorder(SendEntryExpiry)
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	_, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
@@ -161,8 +165,440 @@ func TestSendHasher_Wait_Timeout(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSendHasher_Wait_Timeout_PriorSendInProgress(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+	h.SetPriorSendInProgressError(true)
+
+	// Insert a message into the hasher.
+	_, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotEmpty(t, hash)
+
+	// We should fail with the specific error because the prior message is still being sent.
+	_, _, ok, err = testTryInsert(h, literal1, time.Now().Add(100*time.Millisecond))
+	require.ErrorIs(t, err, ErrPriorSendInProgress)
+	require.False(t, ok)
+
+	// Giving up on the send ourselves is not a timeout on the prior send.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, ok, err = h.TryInsertWait(ctx, hash, nil, time.Now().Add(time.Minute))
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotErrorIs(t, err, ErrPriorSendInProgress)
+	require.False(t, ok)
+}
+
+func TestSendHasher_Wait_Timeout_PriorSendInProgressDisabledByDefault(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	_, _, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, _, _, err = testTryInsert(h, literal1, time.Now().Add(100*time.Millisecond))
+	require.ErrorIs(t, err, ErrSendWaitTimeout)
+	require.NotErrorIs(t, err, ErrPriorSendInProgress)
+}
+
+func TestSendHasher_WaitGetID_SendSuccess(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	hash, err := GetMessageHash([]byte(literal1))
+	require.NoError(t, err)
+
+	// Insert a message into the hasher.
+	srID1, messageID, ok, err := h.TryInsertWaitGetID(context.Background(), hash, nil, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Empty(t, messageID)
+
+	// Simulate successfully sending the message after half a second.
+	go func() {
+		time.Sleep(time.Millisecond * 500)
+		h.SignalMessageSent(hash, srID1, "abc")
+	}()
+
+	// The duplicate should be reported along with the message ID of the original send.
+	srID2, messageID, ok, err := h.TryInsertWaitGetID(context.Background(), hash, nil, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, srID1, srID2)
+	require.Equal(t, "abc", messageID)
+}
+
+func TestSendHasher_WaitGetID_SendFail(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	hash, err := GetMessageHash([]byte(literal1))
+	require.NoError(t, err)
+
+	// Insert a message into the hasher.
+	srID1, _, ok, err := h.TryInsertWaitGetID(context.Background(), hash, nil, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Simulate failing to send the message after half a second.
+	go func() {
+		time.Sleep(time.Millisecond * 500)
+		h.RemoveOnFail(hash, srID1)
+	}()
+
+	// The message should be inserted again, without any message ID.
+	srID2, messageID, ok, err := h.TryInsertWaitGetID(context.Background(), hash, nil, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotEqual(t, srID1, srID2)
+	require.Empty(t, messageID)
+	require.Equal(t, uint64(1), h.Stats().Reinserts)
+}
+
+func TestSendHasher_Wait_TooManyAttempts(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+	h.SetMaxInsertAttempts(1)
+
+	// Insert a message into the hasher.
+	srID1, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Simulate failing to send the message after half a second.
+	go func() {
+		time.Sleep(time.Millisecond * 500)
+		h.RemoveOnFail(hash, srID1)
+	}()
+
+	// The only attempt was spent waiting on the failed message.
+	_, _, _, err = testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.ErrorIs(t, err, ErrTooManyInsertAttempts)
+}
+
+func TestSendHasher_Wait_RepeatedFailures(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+	h.SetMaxInsertAttempts(2)
+
+	hash, err := GetMessageHash([]byte(literal1))
+	require.NoError(t, err)
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	// Every sender which gets to insert the message fails to send it, so the others keep retrying.
+	errs := make(chan error, 10)
+
+	for i := 0; i < cap(errs); i++ {
+		go func() {
+			srID, ok, err := h.TryInsertWait(context.Background(), hash, nil, deadline)
+			if ok {
+				time.Sleep(10 * time.Millisecond)
+				h.RemoveOnFail(hash, srID)
+			}
+
+			errs <- err
+		}()
+	}
+
+	for i := 0; i < cap(errs); i++ {
+		select {
+		case err := <-errs:
+			if err != nil {
+				require.ErrorIs(t, err, ErrTooManyInsertAttempts)
+			}
+
+		case <-time.After(time.Until(deadline)):
+			require.Fail(t, "insert did not terminate")
+		}
+	}
+}
+
+func TestSendHasher_Wait_TimeoutError(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	_, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// The deadline given to the wait passes while the prior send is in flight.
+	_, ok, err = h.TryInsertWait(context.Background(), hash, nil, time.Now().Add(100*time.Millisecond))
+	require.ErrorIs(t, err, ErrSendWaitTimeout)
+	require.NotErrorIs(t, err, context.DeadlineExceeded)
+	require.False(t, ok)
+	require.Equal(t, uint64(1), h.Stats().WaitTimeouts)
+
+	// The caller's context is cancelled while waiting.
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+
+	_, ok, err = h.TryInsertWait(ctx, hash, nil, time.Now().Add(time.Minute))
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotErrorIs(t, err, ErrSendWaitTimeout)
+	require.False(t, ok)
+
+	// The caller's context times out before the deadline given to the wait.
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, ok, err = h.TryInsertWait(ctx, hash, nil, time.Now().Add(time.Minute))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NotErrorIs(t, err, ErrSendWaitTimeout)
+	require.False(t, ok)
+
+	require.Equal(t, uint64(1), h.Stats().WaitTimeouts)
+}
+
+func TestSendHasher_Wait_FanOutAfterFailure(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	srID, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	// Many identical messages wait on the first one; whichever gets to insert it again sends it successfully.
+	type result struct {
+		inserted bool
+		err      error
+	}
+
+	resCh := make(chan result, 50)
+
+	for i := 0; i < cap(resCh); i++ {
+		go func() {
+			srID, ok, err := h.TryInsertWait(context.Background(), hash, nil, deadline)
+			if ok {
+				time.Sleep(10 * time.Millisecond)
+				h.SignalMessageSent(hash, srID, "abc")
+			}
+
+			resCh <- result{inserted: ok, err: err}
+		}()
+	}
+
+	// Let them all wait before the first message fails to send.
+	require.Eventually(t, func() bool {
+		h.entriesLock.Lock()
+		defer h.entriesLock.Unlock()
+
+		return h.entries[hash][0].waiters == cap(resCh)
+	}, time.Second, time.Millisecond)
+
+	h.RemoveOnFail(hash, srID)
+
+	var inserted int
+
+	for i := 0; i < cap(resCh); i++ {
+		select {
+		case res := <-resCh:
+			require.NoError(t, res.err)
+
+			if res.inserted {
+				inserted++
+			}
+
+		case <-time.After(time.Until(deadline)):
+			require.Fail(t, "insert did not terminate")
+		}
+	}
+
+	// Only one of them sent the message again, the others found it sent.
+	require.Equal(t, 1, inserted)
+}
+
+func TestSleepReinsertJitter(t *testing.T) {
+	// The jitter is bounded by a fraction of the time left until the deadline.
+	start := time.Now()
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, sleepReinsertJitter(context.Background(), time.Now().Add(10*time.Millisecond)))
+	}
+
+	require.Less(t, time.Since(start), 10*SendReinsertMaxJitter)
+
+	// There is no jitter past the deadline.
+	require.NoError(t, sleepReinsertJitter(context.Background(), time.Now().Add(-time.Second)))
+
+	// A cancelled context stops the jitter.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorIs(t, sleepReinsertJitter(ctx, time.Now().Add(time.Minute)), context.Canceled)
+}
+
+func TestSendHasher_CancelledContext(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	hash, err := GetMessageHash([]byte(literal1))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+
+	// Nothing should be inserted if the caller already gave up.
+	_, ok, err := h.TryInsertWait(ctx, hash, nil, time.Now().Add(time.Minute))
+	require.ErrorIs(t, err, context.Canceled)
+	require.False(t, ok)
+	require.Zero(t, h.Stats().Inserts)
+
+	// An existing entry should not be waited on either.
+	_, _, ok = h.TryInsert(hash, nil)
+	require.True(t, ok)
+
+	_, ok, err = h.HasEntryWait(ctx, hash, time.Now().Add(time.Minute), nil)
+	require.ErrorIs(t, err, context.Canceled)
+	require.False(t, ok)
+
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestSendHasher_Stats(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+	require.Equal(t, Stats{}, h.Stats())
+
+	// Insert a message and simulate successfully sending it.
+	srID1, hash1, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	h.SignalMessageSent(hash1, srID1, "abc")
+
+	// Inserting it again is a dedup hit, and waiting on it succeeds.
+	_, _, ok, err = testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, Stats{Inserts: 1, DedupHits: 1, Waits: 1}, h.Stats())
+
+	// Insert another message and simulate failing to send it while a duplicate is waiting.
+	srID2, hash2, ok, err := testTryInsert(h, literal2, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		h.RemoveOnFail(hash2, srID2)
+	}()
+
+	// The duplicate is re-inserted once the first attempt failed.
+	_, _, ok, err = testTryInsert(h, literal2, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, Stats{Inserts: 3, DedupHits: 2, Waits: 1, Reinserts: 1}, h.Stats())
+
+	// The re-inserted message is never sent, so waiting on it times out.
+	_, _, _, err = testTryInsert(h, literal2, time.Now().Add(100*time.Millisecond))
+	require.Error(t, err)
+	require.Equal(t, Stats{Inserts: 3, DedupHits: 3, Waits: 1, Reinserts: 1, WaitTimeouts: 1}, h.Stats())
+}
+
+func TestSendHasher_MaxEntries(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, 2)
+
+	hashes := make([]string, 0, 3)
+
+	// Fill the recorder past its cap with sent messages.
+	for _, literal := range []string{literal1, literal2, literal3} {
+		srID, hash, ok, err := testTryInsert(h, literal, time.Now().Add(time.Second))
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		h.SignalMessageSent(hash, srID, hash)
+		hashes = append(hashes, hash)
+	}
+
+	// The oldest entry was evicted.
+	require.Len(t, h.entries, 2)
+	require.NotContains(t, h.entries, hashes[0])
+	require.Contains(t, h.entries, hashes[1])
+	require.Contains(t, h.entries, hashes[2])
+
+	_, ok, err := testHasEntry(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, ok, err = testHasEntry(h, literal3, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestSendHasher_MaxEntries_WaitedEntriesAreKept(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, 2)
+
+	// Insert a message which is still being sent.
+	srID1, hash1, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Wait on it from a duplicate send attempt.
+	resultCh := make(chan bool)
+
+	go func() {
+		_, _, ok, err := testTryInsert(h, literal1, time.Now().Add(5*time.Second))
+		require.NoError(t, err)
+		resultCh <- ok
+	}()
+
+	require.Eventually(t, func() bool {
+		h.entriesLock.Lock()
+		defer h.entriesLock.Unlock()
+
+		return h.entries[hash1][0].waiters == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// Fill the recorder past its cap; the oldest entry is waited on, so the next oldest (sent) entry is evicted instead.
+	srID2, hash2, ok, err := testTryInsert(h, literal2, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	h.SignalMessageSent(hash2, srID2, "def")
+
+	_, hash3, ok, err := testTryInsert(h, literal3, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.Contains(t, h.entries, hash1)
+	require.NotContains(t, h.entries, hash2)
+	require.Contains(t, h.entries, hash3)
+
+	// The in-flight waiter still resolves once the message is sent.
+	h.SignalMessageSent(hash1, srID1, "abc")
+	require.False(t, <-resultCh)
+}
+
+func TestSendHasher_MaxEntries_InFlightEntriesAreKept(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, 1)
+
+	// Insert two messages which are still being sent; the recorder temporarily exceeds its cap.
+	srID1, hash1, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, hash2, ok, err := testTryInsert(h, literal2, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.Contains(t, h.entries, hash1)
+	require.Contains(t, h.entries, hash2)
+
+	// An identical retry during the original send waits for it instead of sending again.
+	resultCh := make(chan bool)
+
+	go func() {
+		_, _, ok, err := testTryInsert(h, literal1, time.Now().Add(5*time.Second))
+		require.NoError(t, err)
+		resultCh <- ok
+	}()
+
+	h.SignalMessageSent(hash1, srID1, "abc")
+	require.False(t, <-resultCh)
+}
+
 func TestSendHasher_HasEntry(t *testing.T) {
-	h := NewSendRecorder(SendEntryExpiry)
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	srID1, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
@@ -181,7 +617,7 @@ func TestSendHasher_HasEntry(t *testing.T) {
 }
 
 func TestSendHasher_HasEntry_SendSuccess(t *testing.T) {
-	h := NewSendRecorder(SendEntryExpiry)
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	srID1, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
@@ -202,12 +638,75 @@ func TestSendHasher_HasEntry_SendSuccess(t *testing.T) {
 	require.Equal(t, "abc", messageID)
 }
 
+func TestSendHasher_HasEntry_TooManyAttempts(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+	h.SetMaxInsertAttempts(1)
+
+	// Insert a message into the hasher.
+	srID1, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Simulate failing to send the message, then retrying it, after a short while.
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		h.RemoveOnFail(hash, srID1)
+		h.TryInsert(hash, nil)
+	}()
+
+	// The only attempt was spent waiting on the failed message, so the retry is not waited on.
+	start := time.Now()
+
+	messageID, ok, err := testHasEntry(h, literal1, time.Now().Add(5*time.Second))
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Empty(t, messageID)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestSendHasher_HasEntry_RepeatedFailures(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+	h.SetMaxInsertAttempts(3)
+
+	hash, err := GetMessageHash([]byte(literal1))
+	require.NoError(t, err)
+
+	// Keep sending and failing to send the message.
+	done := make(chan struct{})
+	defer close(done)
+
+	srID, _, ok := h.TryInsert(hash, nil)
+	require.True(t, ok)
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+
+			case <-time.After(50 * time.Millisecond):
+				h.RemoveOnFail(hash, srID)
+				srID, _, _ = h.TryInsert(hash, nil)
+			}
+		}
+	}()
+
+	// The message is never sent, so it should eventually be reported as not found, well before the deadline.
+	start := time.Now()
+
+	messageID, ok, err := h.HasEntryWait(context.Background(), hash, time.Now().Add(5*time.Second), nil)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Empty(t, messageID)
+	require.Less(t, time.Since(start), time.Second)
+}
+
 func TestSendHasher_DualAddDoesNotCauseCrash(t *testing.T) {
 	// There may be a rare case where one 2 smtp connections attempt to send the same message, but if the first message
 	// is stuck long enough for it to expire, the second connection will remove it from the list and cause it to be
 	// inserted as a new entry. The two clients end up sending the message twice and calling the `SignalMessageSent` x2,
 	// resulting in a crash.
-	h := NewSendRecorder(SendEntryExpiry)
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	srID1, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
@@ -228,7 +727,7 @@ func TestSendHasher_DualAddDoesNotCauseCrash(t *testing.T) {
 }
 
 func TestSendHashed_MessageWithSameHasButDifferentRecipientsIsInserted(t *testing.T) {
-	h := NewSendRecorder(SendEntryExpiry)
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	srID1, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second), "Receiver <receiver@pm.me>")
@@ -249,7 +748,7 @@ func TestSendHashed_MessageWithSameHasButDifferentRecipientsIsInserted(t *testin
 func TestSendHashed_SameMessageWIthDifferentToListShouldWaitSuccessfullyAfterSend(t *testing.T) {
 	// Check that if we send the same message twice with different recipients and the second message is somehow
 	// sent before the first, ensure that we check if the message was sent we wait on the correct object.
-	h := NewSendRecorder(SendEntryExpiry)
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	_, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Minute), "Receiver <receiver@pm.me>")
@@ -273,7 +772,7 @@ func TestSendHashed_SameMessageWIthDifferentToListShouldWaitSuccessfullyAfterSen
 }
 
 func TestSendHasher_HasEntry_SendFail(t *testing.T) {
-	h := NewSendRecorder(SendEntryExpiry)
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	srID1, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
@@ -294,7 +793,7 @@ func TestSendHasher_HasEntry_SendFail(t *testing.T) {
 }
 
 func TestSendHasher_HasEntry_Timeout(t *testing.T) {
-	h := NewSendRecorder(SendEntryExpiry)
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	_, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
@@ -309,7 +808,7 @@ func TestSendHasher_HasEntry_Timeout(t *testing.T) {
 }
 
 func TestSendHasher_HasEntry_Expired(t *testing.T) {
-	h := NewSendRecorder(time.Second)
+	h := NewSendRecorder(time.Second, SendMaxEntries)
 
 	// Insert a message into the hasher.
 	srID1, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
@@ -329,14 +828,274 @@ func TestSendHasher_HasEntry_Expired(t *testing.T) {
 	require.False(t, ok)
 }
 
-const literal1 = `From: Sender <sender@pm.me>
-To: Receiver <receiver@pm.me>
-Content-Type: multipart/mixed; boundary=longrandomstring
+func TestSendHasher_Insert_PerEntryTTL(t *testing.T) {
+	h := NewSendRecorder(time.Hour, SendMaxEntries)
 
---longrandomstring
+	hash1, err := GetMessageHash([]byte(literal1))
+	require.NoError(t, err)
 
-body
---longrandomstring
+	hash2, err := GetMessageHash([]byte(literal2))
+	require.NoError(t, err)
+
+	// Insert a message with a short TTL and one with a long TTL.
+	srID1, ok, err := h.TryInsertWaitTTL(context.Background(), hash1, nil, time.Now().Add(time.Second), time.Second)
+	require.NoError(t, err)
+	require.True(t, ok)
+	h.SignalMessageSent(hash1, srID1, "abc")
+
+	srID2, ok, err := h.TryInsertWaitTTL(context.Background(), hash2, nil, time.Now().Add(time.Second), time.Minute)
+	require.NoError(t, err)
+	require.True(t, ok)
+	h.SignalMessageSent(hash2, srID2, "def")
+
+	// Wait for the short TTL entry to expire.
+	time.Sleep(time.Second)
+
+	// The short TTL entry has expired; we should not find it in the hasher.
+	_, ok, err = testHasEntry(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// The long TTL entry is still there.
+	msgID, ok, err := testHasEntry(h, literal2, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "def", msgID)
+
+	// The short TTL message can be inserted again, the long TTL one cannot.
+	_, _, ok, err = testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, _, ok, err = testTryInsert(h, literal2, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestSendHasher_Insert_DefaultTTL(t *testing.T) {
+	h := NewSendRecorder(time.Second, SendMaxEntries)
+
+	hash, err := GetMessageHash([]byte(literal1))
+	require.NoError(t, err)
+
+	// A non-positive TTL falls back to the recorder expiry.
+	srID, _, ok := h.TryInsertTTL(hash, nil, 0)
+	require.True(t, ok)
+	h.SignalMessageSent(hash, srID, "abc")
+
+	_, ok, err = testHasEntry(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Wait for the entry to expire.
+	time.Sleep(time.Second)
+
+	_, ok, err = testHasEntry(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestSendHasher_SignalMessageSent_Expired(t *testing.T) {
+	h := NewSendRecorder(100*time.Millisecond, SendMaxEntries)
+
+	// Insert a message into the hasher.
+	srID, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Let the entry expire, then prune it by inserting another message.
+	time.Sleep(200 * time.Millisecond)
+
+	_, _, ok, err = testTryInsert(h, literal2, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotContains(t, h.entries, hash)
+
+	// Signalling the pruned entry as sent must not panic.
+	require.NotPanics(t, func() { h.SignalMessageSent(hash, srID, "abc") })
+}
+
+func TestSendHasher_Janitor(t *testing.T) {
+	h := NewSendRecorder(time.Second, SendMaxEntries)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h.StartJanitor(ctx, 100*time.Millisecond)
+	defer h.Close()
+
+	// Insert some messages.
+	for _, literal := range []string{literal1, literal2} {
+		srID, hash, ok, err := testTryInsert(h, literal, time.Now().Add(time.Second))
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		h.SignalMessageSent(hash, srID, hash)
+	}
+
+	// Without any further access, the janitor eventually removes the expired entries.
+	require.Eventually(t, func() bool {
+		h.entriesLock.Lock()
+		defer h.entriesLock.Unlock()
+
+		return len(h.entries) == 0 && len(h.expiries) == 0
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestSendHasher_Janitor_Close(t *testing.T) {
+	h := NewSendRecorder(time.Second, SendMaxEntries)
+
+	h.StartJanitor(context.Background(), 100*time.Millisecond)
+
+	// Closing stops the janitor; closing again is a no-op.
+	h.Close()
+	h.Close()
+
+	// Expired entries are no longer swept in the background.
+	_, _, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	time.Sleep(1500 * time.Millisecond)
+
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	require.Len(t, h.entries, 1)
+}
+
+func TestSendHasher_IgnoredHeaders(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	lit1 := []byte("To: a@b.c\r\nReply-To: token-1@b.c\r\nSubject: Hello\r\n\r\nHello")
+	lit2 := []byte("To: a@b.c\r\nReply-To: token-2@b.c\r\nSubject: Hello\r\n\r\nHello")
+
+	// Without any ignored header, the messages are different.
+	hash1, err := h.HashMessage(lit1)
+	require.NoError(t, err)
+
+	hash2, err := h.HashMessage(lit2)
+	require.NoError(t, err)
+
+	require.NotEqual(t, hash1, hash2)
+
+	// Ignore the varying header; patterns are matched case-insensitively.
+	require.NoError(t, h.SetIgnoredHeaders([]string{"REPLY-*"}))
+
+	hash1, err = h.HashMessage(lit1)
+	require.NoError(t, err)
+
+	hash2, err = h.HashMessage(lit2)
+	require.NoError(t, err)
+
+	require.Equal(t, hash1, hash2)
+
+	// The second send is now deduplicated.
+	srID, ok, err := h.TryInsertWait(context.Background(), hash1, nil, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	h.SignalMessageSent(hash1, srID, "abc")
+
+	_, ok, err = h.TryInsertWait(context.Background(), hash2, nil, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Other headers are still part of the hash.
+	hash3, err := h.HashMessage([]byte("To: a@b.c\r\nReply-To: token-1@b.c\r\nSubject: Goodbye\r\n\r\nHello"))
+	require.NoError(t, err)
+	require.NotEqual(t, hash1, hash3)
+}
+
+func TestSendHasher_IgnoredHeaders_BadPattern(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	require.Error(t, h.SetIgnoredHeaders([]string{"reply-["}))
+}
+
+func TestSendHasher_Disabled(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+	h.SetEnabled(false)
+
+	// No hash is returned, so that the message is handled without going through the recorder.
+	hash, err := h.HashMessage([]byte(literal1))
+	require.NoError(t, err)
+	require.Empty(t, hash)
+
+	// Not even for messages which cannot be hashed.
+	hash, err = h.HashMessage([]byte(literalBadEncoding))
+	require.NoError(t, err)
+	require.Empty(t, hash)
+
+	// Once enabled again, messages are hashed as usual.
+	h.SetEnabled(true)
+
+	hash, err = h.HashMessage([]byte(literal1))
+	require.NoError(t, err)
+	require.NotEmpty(t, hash)
+
+	// Messages hashed while enabled can still be found.
+	srID, _, ok := h.TryInsert(hash, nil)
+	require.True(t, ok)
+
+	h.SignalMessageSent(hash, srID, "abc")
+
+	messageID, ok, err := h.HasEntryWait(context.Background(), hash, time.Now().Add(time.Second), nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "abc", messageID)
+}
+
+func TestSendHasher_HashErrorPolicy_Fail(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	// The default policy returns the hash error.
+	_, err := h.HashMessage([]byte(literalBadEncoding))
+	require.Error(t, err)
+
+	h.SetHashErrorPolicy(HashErrorPolicyFail)
+
+	_, err = h.HashMessage([]byte(literalBadEncoding))
+	require.Error(t, err)
+
+	// Valid messages are hashed as usual.
+	hash, err := h.HashMessage([]byte(literal1))
+	require.NoError(t, err)
+	require.NotEmpty(t, hash)
+}
+
+func TestSendHasher_HashErrorPolicy_SendWithoutDedup(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+	h.SetHashErrorPolicy(HashErrorPolicySendWithoutDedup)
+
+	// The hash error is swallowed and no hash is returned.
+	hash, err := h.HashMessage([]byte(literalBadEncoding))
+	require.NoError(t, err)
+	require.Empty(t, hash)
+
+	// Signalling the send of an unrecorded message is harmless and does not create an entry.
+	h.SignalMessageSent(hash, 0, "abc")
+	h.RemoveOnFail(hash, 0)
+
+	_, ok, err := h.HasEntryWait(context.Background(), hash, time.Now().Add(time.Second), nil)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Empty(t, h.entries)
+
+	// Valid messages are still deduplicated.
+	hash, err = h.HashMessage([]byte(literal1))
+	require.NoError(t, err)
+	require.NotEmpty(t, hash)
+}
+
+const literal1 = `From: Sender <sender@pm.me>
+To: Receiver <receiver@pm.me>
+Content-Type: multipart/mixed; boundary=longrandomstring
+
+--longrandomstring
+
+body
+--longrandomstring
 Content-Disposition: attachment; filename="attname.txt"
 
 attachment
@@ -356,6 +1115,29 @@ attachment
 --longrandomstring--
 `
 
+const literal3 = `From: Sender <sender@pm.me>
+To: Receiver <receiver@pm.me>
+Content-Type: multipart/mixed; boundary=longrandomstring
+
+--longrandomstring
+
+body
+--longrandomstring
+Content-Disposition: attachment; filename="attname3.txt"
+
+attachment
+--longrandomstring--
+`
+
+// literalBadEncoding has a text part which claims to be base64 encoded but is not, so it cannot be hashed.
+const literalBadEncoding = `From: Sender <sender@pm.me>
+To: Receiver <receiver@pm.me>
+Content-Type: text/plain
+Content-Transfer-Encoding: base64
+
+this is *not* base64!
+`
+
 func TestGetMessageHash(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -428,6 +1210,36 @@ func TestGetMessageHash(t *testing.T) {
 			lit2:      []byte("To: a@b.c\r\nDate: Sat, 14 Aug 1982\r\nMessage-Id: 2@b.c\r\n\r\nHello"),
 			wantEqual: true,
 		},
+		{
+			name:      "duplicated subject",
+			lit1:      []byte("Subject: Hello\r\nTo: a@b.c\r\n\r\nHello"),
+			lit2:      []byte("Subject: Hello\r\nSubject: World\r\nTo: a@b.c\r\n\r\nHello"),
+			wantEqual: false,
+		},
+		{
+			name:      "duplicated empty subject",
+			lit1:      []byte("Subject: Hello\r\nTo: a@b.c\r\n\r\nHello"),
+			lit2:      []byte("Subject: Hello\r\nSubject: \r\nTo: a@b.c\r\n\r\nHello"),
+			wantEqual: false,
+		},
+		{
+			name:      "duplicated from",
+			lit1:      []byte("From: a@b.c\r\nTo: a@b.c\r\n\r\nHello"),
+			lit2:      []byte("From: a@b.c\r\nFrom: d@e.f\r\nTo: a@b.c\r\n\r\nHello"),
+			wantEqual: false,
+		},
+		{
+			name:      "duplicated from in different order",
+			lit1:      []byte("From: a@b.c\r\nFrom: d@e.f\r\nTo: a@b.c\r\n\r\nHello"),
+			lit2:      []byte("From: d@e.f\r\nFrom: a@b.c\r\nTo: a@b.c\r\n\r\nHello"),
+			wantEqual: false,
+		},
+		{
+			name:      "same duplicated headers",
+			lit1:      []byte("Subject: Hello\r\nSubject: World\r\nFrom: a@b.c\r\nFrom: d@e.f\r\n\r\nHello"),
+			lit2:      []byte("Subject: Hello\r\nSubject: World\r\nFrom: a@b.c\r\nFrom: d@e.f\r\n\r\nHello"),
+			wantEqual: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -447,25 +1259,1041 @@ func TestGetMessageHash(t *testing.T) {
 	}
 }
 
-func testTryInsert(h *SendRecorder, literal string, deadline time.Time, toList ...string) (ID, string, bool, error) { //nolint:unparam
-	hash, err := GetMessageHash([]byte(literal))
-	if err != nil {
-		return 0, "", false, err
+func TestGetMessageHash_AddressNormalization(t *testing.T) {
+	tests := []struct {
+		name       string
+		lit1, lit2 string
+		wantEqual  bool
+	}{
+		{
+			name:      "reordered recipients",
+			lit1:      "To: a@pm.me, b@pm.me\r\n\r\nHello",
+			lit2:      "To: b@pm.me, a@pm.me\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "display name added",
+			lit1:      "To: Alice <a@pm.me>\r\n\r\nHello",
+			lit2:      "To: a@pm.me\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "display name changed",
+			lit1:      "From: Alice <a@pm.me>\r\n\r\nHello",
+			lit2:      "From: \"Alice A.\" <a@pm.me>\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "domain case",
+			lit1:      "Cc: a@PM.me\r\n\r\nHello",
+			lit2:      "Cc: a@pm.me\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "local part case",
+			lit1:      "Cc: Alice@pm.me\r\n\r\nHello",
+			lit2:      "Cc: alice@pm.me\r\n\r\nHello",
+			wantEqual: false,
+		},
+		{
+			name:      "repeated recipient",
+			lit1:      "To: a@pm.me, a@pm.me\r\n\r\nHello",
+			lit2:      "To: a@pm.me\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "additional recipient",
+			lit1:      "To: a@pm.me, b@pm.me\r\n\r\nHello",
+			lit2:      "To: a@pm.me\r\n\r\nHello",
+			wantEqual: false,
+		},
+		{
+			name:      "different recipient",
+			lit1:      "To: Alice <a@pm.me>\r\n\r\nHello",
+			lit2:      "To: Alice <b@pm.me>\r\n\r\nHello",
+			wantEqual: false,
+		},
+		{
+			name:      "recipient moved from to to cc",
+			lit1:      "To: a@pm.me\r\nCc: b@pm.me\r\n\r\nHello",
+			lit2:      "To: b@pm.me\r\nCc: a@pm.me\r\n\r\nHello",
+			wantEqual: false,
+		},
 	}
 
-	srID, ok, err := h.TryInsertWait(context.Background(), hash, toList, deadline)
-	if err != nil {
-		return 0, "", false, err
-	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash1, err := GetMessageHash([]byte(tt.lit1))
+			require.NoError(t, err)
 
-	return srID, hash, ok, nil
-}
+			hash2, err := GetMessageHash([]byte(tt.lit2))
+			require.NoError(t, err)
 
-func testHasEntry(h *SendRecorder, literal string, deadline time.Time, toList ...string) (string, bool, error) { //nolint:unparam
-	hash, err := GetMessageHash([]byte(literal))
-	if err != nil {
-		return "", false, err
+			if tt.wantEqual {
+				require.Equal(t, hash1, hash2)
+			} else {
+				require.NotEqual(t, hash1, hash2)
+			}
+		})
 	}
+}
 
-	return h.HasEntryWait(context.Background(), hash, deadline, toList)
+func TestGetMessageHash_HeaderWhitespace(t *testing.T) {
+	tests := []struct {
+		name       string
+		lit1, lit2 string
+		wantEqual  bool
+	}{
+		{
+			name:      "collapsed whitespace",
+			lit1:      "Subject: Hello   world\r\n\r\nHello",
+			lit2:      "Subject: Hello world\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "tabs",
+			lit1:      "Subject: Hello\tworld\r\n\r\nHello",
+			lit2:      "Subject: Hello world\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "folded subject",
+			lit1:      "Subject: Hello\r\n world\r\n\r\nHello",
+			lit2:      "Subject: Hello world\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "folded subject with tab",
+			lit1:      "Subject: Hello\r\n\tworld\r\n\r\nHello",
+			lit2:      "Subject: Hello world\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "trailing whitespace",
+			lit1:      "Subject: Hello world  \r\n\r\nHello",
+			lit2:      "Subject: Hello world\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "folded recipients",
+			lit1:      "To: Alice <a@pm.me>,\r\n Bob <b@pm.me>\r\n\r\nHello",
+			lit2:      "To: Alice <a@pm.me>, Bob <b@pm.me>\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "folded display name",
+			lit1:      "From: \"Alice\r\n   A.\" <a@pm.me>\r\n\r\nHello",
+			lit2:      "From: Alice <a@pm.me>\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "folded and reordered recipients",
+			lit1:      "To: b@pm.me,\r\n\ta@pm.me\r\n\r\nHello",
+			lit2:      "To: a@pm.me, b@pm.me\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "folded different recipient",
+			lit1:      "To: a@pm.me,\r\n c@pm.me\r\n\r\nHello",
+			lit2:      "To: a@pm.me, b@pm.me\r\n\r\nHello",
+			wantEqual: false,
+		},
+		{
+			name:      "different words",
+			lit1:      "Subject: Hello world\r\n\r\nHello",
+			lit2:      "Subject: Helloworld\r\n\r\nHello",
+			wantEqual: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash1, err := GetMessageHash([]byte(tt.lit1))
+			require.NoError(t, err)
+
+			hash2, err := GetMessageHash([]byte(tt.lit2))
+			require.NoError(t, err)
+
+			if tt.wantEqual {
+				require.Equal(t, hash1, hash2)
+			} else {
+				require.NotEqual(t, hash1, hash2)
+			}
+		})
+	}
+
+	// The strict profile hashes headers as they are sent.
+	hash1, err := getMessageHash([]byte("Subject: Hello   world\r\n\r\nHello"), hashOptions{profile: NormalizationProfileStrict})
+	require.NoError(t, err)
+
+	hash2, err := getMessageHash([]byte("Subject: Hello world\r\n\r\nHello"), hashOptions{profile: NormalizationProfileStrict})
+	require.NoError(t, err)
+
+	require.NotEqual(t, hash1, hash2)
+}
+
+func TestGetMessageHashWithMessageID(t *testing.T) {
+	tests := []struct {
+		name       string
+		lit1, lit2 string
+		wantEqual  bool
+	}{
+		{
+			name:      "same message ID, different body",
+			lit1:      "To: a@pm.me\r\nMessage-Id: <1@pm.me>\r\n\r\nHello",
+			lit2:      "To: a@pm.me\r\nMessage-Id: <1@pm.me>\r\n\r\nHello world",
+			wantEqual: true,
+		},
+		{
+			name:      "different message ID, same content",
+			lit1:      "To: a@pm.me\r\nMessage-Id: <1@pm.me>\r\n\r\nHello",
+			lit2:      "To: a@pm.me\r\nMessage-Id: <2@pm.me>\r\n\r\nHello",
+			wantEqual: false,
+		},
+		{
+			name:      "no message ID, same content",
+			lit1:      "To: a@pm.me\r\n\r\nHello",
+			lit2:      "To: a@pm.me\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "no message ID, different body",
+			lit1:      "To: a@pm.me\r\n\r\nHello",
+			lit2:      "To: a@pm.me\r\n\r\nHello world",
+			wantEqual: false,
+		},
+		{
+			name:      "message ID on one message only",
+			lit1:      "To: a@pm.me\r\nMessage-Id: <1@pm.me>\r\n\r\nHello",
+			lit2:      "To: a@pm.me\r\n\r\nHello",
+			wantEqual: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash1, err := GetMessageHashWithMessageID([]byte(tt.lit1))
+			require.NoError(t, err)
+
+			hash2, err := GetMessageHashWithMessageID([]byte(tt.lit2))
+			require.NoError(t, err)
+
+			if tt.wantEqual {
+				require.Equal(t, hash1, hash2)
+			} else {
+				require.NotEqual(t, hash1, hash2)
+			}
+		})
+	}
+}
+
+func TestGetMessageHashWithMessageID_FallsBackToContent(t *testing.T) {
+	lit := []byte("To: a@pm.me\r\nSubject: Hello\r\n\r\nHello")
+
+	hash1, err := GetMessageHash(lit)
+	require.NoError(t, err)
+
+	hash2, err := GetMessageHashWithMessageID(lit)
+	require.NoError(t, err)
+
+	require.Equal(t, hash1, hash2)
+}
+
+func TestSendHasher_UseMessageID(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	lit1 := []byte("To: a@pm.me\r\nMessage-Id: <1@pm.me>\r\nContent-Type: text/plain\r\n\r\nHello")
+	lit2 := []byte("To: a@pm.me\r\nMessage-Id: <1@pm.me>\r\nContent-Type: text/plain\r\nContent-Transfer-Encoding: quoted-printable\r\n\r\nHello=\r\n")
+
+	// By default, the Message-ID is not part of the hash.
+	hash1, err := h.HashMessage(lit1)
+	require.NoError(t, err)
+
+	hash2, err := h.HashMessage([]byte("To: a@pm.me\r\nMessage-Id: <1@pm.me>\r\nContent-Type: text/plain\r\n\r\nGoodbye"))
+	require.NoError(t, err)
+
+	require.NotEqual(t, hash1, hash2)
+
+	// Once enabled, messages sharing a Message-ID hash the same.
+	h.SetUseMessageID(true)
+
+	hash1, err = h.HashMessage(lit1)
+	require.NoError(t, err)
+
+	hash2, err = h.HashMessage(lit2)
+	require.NoError(t, err)
+
+	require.Equal(t, hash1, hash2)
+}
+
+func TestGetMessageHash_IdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		lit1, lit2 string
+		wantEqual  bool
+	}{
+		{
+			name:      "same key, different body",
+			lit1:      "To: a@pm.me\r\nX-Idempotency-Key: key1\r\n\r\nHello",
+			lit2:      "To: a@pm.me\r\nX-Idempotency-Key: key1\r\n\r\nHello world",
+			wantEqual: true,
+		},
+		{
+			name:      "same key, different message ID",
+			lit1:      "To: a@pm.me\r\nMessage-Id: <1@pm.me>\r\nX-Idempotency-Key: key1\r\n\r\nHello",
+			lit2:      "To: a@pm.me\r\nMessage-Id: <2@pm.me>\r\nX-Idempotency-Key: key1\r\n\r\nHello",
+			wantEqual: true,
+		},
+		{
+			name:      "different key, same content",
+			lit1:      "To: a@pm.me\r\nX-Idempotency-Key: key1\r\n\r\nHello",
+			lit2:      "To: a@pm.me\r\nX-Idempotency-Key: key2\r\n\r\nHello",
+			wantEqual: false,
+		},
+		{
+			name:      "empty key, same content",
+			lit1:      "To: a@pm.me\r\nX-Idempotency-Key: \r\n\r\nHello",
+			lit2:      "To: a@pm.me\r\n\r\nHello",
+			wantEqual: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash1, err := GetMessageHash([]byte(tt.lit1))
+			require.NoError(t, err)
+
+			hash2, err := GetMessageHash([]byte(tt.lit2))
+			require.NoError(t, err)
+
+			if tt.wantEqual {
+				require.Equal(t, hash1, hash2)
+			} else {
+				require.NotEqual(t, hash1, hash2)
+			}
+		})
+	}
+}
+
+func TestSendHasher_IdempotencyKey(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	hash1, err := h.HashMessage([]byte("To: a@pm.me\r\nX-Idempotency-Key: key1\r\n\r\nHello"))
+	require.NoError(t, err)
+
+	hash2, err := h.HashMessage([]byte("To: b@pm.me\r\nX-Idempotency-Key: key1\r\n\r\nGoodbye"))
+	require.NoError(t, err)
+
+	srID, ok, err := h.TryInsertWait(context.Background(), hash1, []string{"a@pm.me"}, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	h.SignalMessageSent(hash1, srID, "messageID")
+
+	// The second message is a duplicate, even though its body and recipients differ.
+	_, ok, err = h.TryInsertWait(context.Background(), hash2, []string{"b@pm.me"}, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestSendHasher_IdempotencyPrefix_CustomHasher(t *testing.T) {
+	// A custom hasher may return hashes which look like idempotency hashes; they still match on their recipients.
+	h := NewSendRecorderWithHasher(SendEntryExpiry, SendMaxEntries, func([]byte) (string, error) {
+		return idempotencyHashPrefix + "hash", nil
+	})
+
+	hash, err := h.HashMessage([]byte("To: a@pm.me\r\n\r\nHello"))
+	require.NoError(t, err)
+
+	srID, ok, err := h.TryInsertWait(context.Background(), hash, []string{"a@pm.me"}, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	h.SignalMessageSent(hash, srID, "messageID")
+
+	_, ok, err = h.TryInsertWait(context.Background(), hash, []string{"b@pm.me"}, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestStripIdempotencyKey(t *testing.T) {
+	lit := []byte("To: a@pm.me\r\nX-Idempotency-Key: key1\r\nSubject: Hello\r\nx-idempotency-key: key2\r\n\r\nHello\r\n")
+
+	stripped := StripIdempotencyKey(lit)
+	require.Equal(t, "To: a@pm.me\r\nSubject: Hello\r\n\r\nHello\r\n", string(stripped))
+
+	// The original message is left untouched.
+	require.Contains(t, string(lit), "X-Idempotency-Key: key1")
+
+	// Messages without the header are returned as is.
+	lit = []byte("To: a@pm.me\r\nSubject: Hello\r\n\r\nHello\r\n")
+	require.Equal(t, lit, StripIdempotencyKey(lit))
+}
+
+func TestGetMessageHash_SignedMessage(t *testing.T) {
+	signed := func(signature string) []byte {
+		return []byte("To: a@pm.me\r\n" +
+			"Content-Type: multipart/signed; micalg=pgp-sha256; protocol=\"application/pgp-signature\"; boundary=\"sig\"\r\n" +
+			"\r\n" +
+			"--sig\r\n" +
+			"Content-Type: text/plain\r\n" +
+			"\r\n" +
+			"Hello world!\r\n" +
+			"--sig\r\n" +
+			"Content-Type: application/pgp-signature; name=\"signature.asc\"\r\n" +
+			"\r\n" +
+			"-----BEGIN PGP SIGNATURE-----\r\n" +
+			signature + "\r\n" +
+			"-----END PGP SIGNATURE-----\r\n" +
+			"--sig--\r\n")
+	}
+
+	// By default, only the signature differing does not change the hash.
+	hash1, err := GetMessageHash(signed("c2lnbmF0dXJlMQ=="))
+	require.NoError(t, err)
+
+	hash2, err := GetMessageHash(signed("c2lnbmF0dXJlMg=="))
+	require.NoError(t, err)
+
+	require.Equal(t, hash1, hash2)
+
+	// The signed content is still part of the hash.
+	hash3, err := GetMessageHash(bytes.Replace(signed("c2lnbmF0dXJlMQ=="), []byte("Hello world!"), []byte("Goodbye world!"), 1))
+	require.NoError(t, err)
+
+	require.NotEqual(t, hash1, hash3)
+
+	// Signatures can be included in the hash.
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+	h.SetHashSignatures(true)
+
+	hash1, err = h.HashMessage(signed("c2lnbmF0dXJlMQ=="))
+	require.NoError(t, err)
+
+	hash2, err = h.HashMessage(signed("c2lnbmF0dXJlMg=="))
+	require.NoError(t, err)
+
+	require.NotEqual(t, hash1, hash2)
+}
+
+func testTryInsert(h *SendRecorder, literal string, deadline time.Time, toList ...string) (ID, string, bool, error) { //nolint:unparam
+	hash, err := GetMessageHash([]byte(literal))
+	if err != nil {
+		return 0, "", false, err
+	}
+
+	srID, ok, err := h.TryInsertWait(context.Background(), hash, toList, deadline)
+	if err != nil {
+		return 0, "", false, err
+	}
+
+	return srID, hash, ok, nil
+}
+
+func testHasEntry(h *SendRecorder, literal string, deadline time.Time, toList ...string) (string, bool, error) { //nolint:unparam
+	hash, err := GetMessageHash([]byte(literal))
+	if err != nil {
+		return "", false, err
+	}
+
+	return h.HasEntryWait(context.Background(), hash, deadline, toList)
+}
+
+func TestGetMessageHash_NormalizationProfiles(t *testing.T) {
+	// The same message, as serialized by two different clients.
+	lit1 := []byte("Subject: Hello\r\n" +
+		"From: Alice <alice@Example.com>\r\n" +
+		"To: bob@pm.me, carol@pm.me\r\n" +
+		"Content-Type: text/plain; charset=utf-8\r\n" +
+		"Content-Transfer-Encoding: quoted-printable\r\n" +
+		"\r\n" +
+		"Hello=20world!\r\n")
+	lit2 := []byte("Subject: Hello\n" +
+		"From: alice@example.com\n" +
+		"To: carol@pm.me, bob@pm.me\n" +
+		"Content-Type: text/plain; charset=utf-8\n" +
+		"\n" +
+		"Hello world!\n")
+
+	hash := func(profile NormalizationProfile, b []byte) string {
+		hash, err := getMessageHash(b, hashOptions{profile: profile})
+		require.NoError(t, err)
+
+		return hash
+	}
+
+	// The lenient profile is the default.
+	require.Equal(t, hash("", lit1), hash(NormalizationProfileLenient, lit1))
+
+	for _, profile := range []NormalizationProfile{
+		NormalizationProfileLenient,
+		NormalizationProfileThunderbird,
+		NormalizationProfileAppleMail,
+	} {
+		require.Equal(t, hash(profile, lit1), hash(profile, lit2), profile)
+	}
+
+	require.NotEqual(t, hash(NormalizationProfileStrict, lit1), hash(NormalizationProfileStrict, lit2))
+}
+
+func TestGetMessageHash_NormalizationProfiles_ClientSpecific(t *testing.T) {
+	// Thunderbird leaves trailing spaces on the soft line breaks of format=flowed text.
+	flowed := []byte("To: a@pm.me\r\nContent-Type: text/plain; charset=utf-8; format=flowed\r\n\r\nHello \r\nworld!\r\n")
+	plain := []byte("To: a@pm.me\r\nContent-Type: text/plain; charset=utf-8; format=flowed\r\n\r\nHello\r\nworld!\r\n")
+
+	// Apple Mail picks the charset depending on the content.
+	ascii := []byte("To: a@pm.me\r\nContent-Type: text/plain; charset=us-ascii\r\n\r\nHello world!\r\n")
+	utf8 := []byte("To: a@pm.me\r\nContent-Type: text/plain; charset=utf-8\r\n\r\nHello world!\r\n")
+
+	hash := func(profile NormalizationProfile, b []byte) string {
+		hash, err := getMessageHash(b, hashOptions{profile: profile})
+		require.NoError(t, err)
+
+		return hash
+	}
+
+	require.NotEqual(t, hash(NormalizationProfileLenient, flowed), hash(NormalizationProfileLenient, plain))
+	require.Equal(t, hash(NormalizationProfileThunderbird, flowed), hash(NormalizationProfileThunderbird, plain))
+
+	require.NotEqual(t, hash(NormalizationProfileThunderbird, ascii), hash(NormalizationProfileThunderbird, utf8))
+	require.Equal(t, hash(NormalizationProfileAppleMail, ascii), hash(NormalizationProfileAppleMail, utf8))
+}
+
+func TestSendHasher_NormalizationProfile(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	lit1 := []byte("To: a@pm.me\r\nContent-Type: text/plain\r\n\r\nHello\r\n")
+	lit2 := []byte("To: a@pm.me\nContent-Type: text/plain\n\nHello\n")
+
+	hash1, err := h.HashMessage(lit1)
+	require.NoError(t, err)
+
+	hash2, err := h.HashMessage(lit2)
+	require.NoError(t, err)
+
+	require.Equal(t, hash1, hash2)
+
+	require.NoError(t, h.SetNormalizationProfile("strict"))
+
+	hash1, err = h.HashMessage(lit1)
+	require.NoError(t, err)
+
+	hash2, err = h.HashMessage(lit2)
+	require.NoError(t, err)
+
+	require.NotEqual(t, hash1, hash2)
+
+	require.ErrorIs(t, h.SetNormalizationProfile("outlook"), ErrUnknownNormalizationProfile)
+}
+
+func TestGetMessageHash_IncludeStructure(t *testing.T) {
+	// Both messages hash the same fragments: the headers, and the plain text part with its body. The second message
+	// however also has an empty multipart container, which is not a leaf part and so is not hashed otherwise.
+	lit1 := []byte("To: a@pm.me\r\nContent-Type: multipart/mixed; boundary=\"b\"\r\n\r\n" +
+		"--b\r\nContent-Type: text/plain\r\n\r\nHello\r\n--b--\r\n")
+	lit2 := []byte("To: a@pm.me\r\nContent-Type: multipart/mixed; boundary=\"b\"\r\n\r\n" +
+		"--b\r\nContent-Type: multipart/alternative; boundary=\"c\"\r\n\r\n" +
+		"--c\r\nContent-Type: text/plain\r\n\r\nHello\r\n--c--\r\n--b--\r\n")
+
+	hash1, err := getMessageHash(lit1, hashOptions{})
+	require.NoError(t, err)
+
+	hash2, err := getMessageHash(lit2, hashOptions{})
+	require.NoError(t, err)
+
+	require.Equal(t, hash1, hash2)
+
+	hash1, err = getMessageHash(lit1, hashOptions{includeStructure: true})
+	require.NoError(t, err)
+
+	hash2, err = getMessageHash(lit2, hashOptions{includeStructure: true})
+	require.NoError(t, err)
+
+	require.NotEqual(t, hash1, hash2)
+
+	// The same message still hashes the same.
+	hash2, err = getMessageHash(lit1, hashOptions{includeStructure: true})
+	require.NoError(t, err)
+
+	require.Equal(t, hash1, hash2)
+}
+
+func TestSendHasher_HashStructure(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	lit := []byte("To: a@pm.me\r\nContent-Type: text/plain\r\n\r\nHello\r\n")
+
+	// Disabled by default, so that hashes are unchanged.
+	hash, err := h.HashMessage(lit)
+	require.NoError(t, err)
+
+	expected, err := GetMessageHash(lit)
+	require.NoError(t, err)
+
+	require.Equal(t, expected, hash)
+
+	h.SetHashStructure(true)
+
+	hash, err = h.HashMessage(lit)
+	require.NoError(t, err)
+
+	require.NotEqual(t, expected, hash)
+}
+
+func TestGetMessageHash_DateBucket(t *testing.T) {
+	const bucket = 5 * time.Minute
+
+	message := func(date string) []byte {
+		return []byte("Date: " + date + "\r\nTo: a@pm.me\r\nContent-Type: text/plain\r\n\r\nHello\r\n")
+	}
+
+	hash := func(b []byte, bucket time.Duration) string {
+		hash, err := getMessageHash(b, hashOptions{dateBucket: bucket})
+		require.NoError(t, err)
+
+		return hash
+	}
+
+	sent := message("Mon, 02 Jan 2006 15:01:00 +0000")
+	sameWindow := message("Mon, 02 Jan 2006 15:04:59 +0000")
+	sameWindowOtherZone := message("Mon, 02 Jan 2006 16:03:00 +0100")
+	nextWindow := message("Mon, 02 Jan 2006 15:05:00 +0000")
+	nextDay := message("Tue, 03 Jan 2006 15:01:00 +0000")
+
+	// Disabled, the date is not part of the hash.
+	require.Equal(t, hash(sent, 0), hash(nextDay, 0))
+
+	// Enabled, sends in the same window hash the same, whatever the time zone.
+	require.Equal(t, hash(sent, bucket), hash(sameWindow, bucket))
+	require.Equal(t, hash(sent, bucket), hash(sameWindowOtherZone, bucket))
+
+	// Sends in distinct windows do not.
+	require.NotEqual(t, hash(sent, bucket), hash(nextWindow, bucket))
+	require.NotEqual(t, hash(sent, bucket), hash(nextDay, bucket))
+
+	// Messages without a valid date hash as if disabled.
+	noDate := []byte("To: a@pm.me\r\nContent-Type: text/plain\r\n\r\nHello\r\n")
+	require.Equal(t, hash(noDate, 0), hash(noDate, bucket))
+	require.Equal(t, hash(noDate, 0), hash(message("not a date"), bucket))
+}
+
+func TestSendHasher_Hasher(t *testing.T) {
+	errUnparsable := errors.New("unparsable")
+
+	// The stub hashes messages by their first line alone, and fails on empty messages.
+	hasher := func(b []byte) (string, error) {
+		if len(b) == 0 {
+			return "", errUnparsable
+		}
+
+		line, _, _ := bytes.Cut(b, []byte("\n"))
+
+		return string(line), nil
+	}
+
+	h := NewSendRecorderWithHasher(SendEntryExpiry, SendMaxEntries, hasher)
+
+	hash := func(b string) string {
+		hash, err := h.HashMessage([]byte(b))
+		require.NoError(t, err)
+
+		return hash
+	}
+
+	insert := func(hash string) bool {
+		srID, ok, err := h.TryInsertWait(context.Background(), hash, nil, time.Now().Add(time.Second))
+		require.NoError(t, err)
+
+		if ok {
+			h.SignalMessageSent(hash, srID, "abc")
+		}
+
+		return ok
+	}
+
+	// Messages with the same first line are deduplicated, even though their content differs.
+	require.Equal(t, "Subject: Hello", hash("Subject: Hello\n\nfirst body"))
+	require.True(t, insert(hash("Subject: Hello\n\nfirst body")))
+	require.False(t, insert(hash("Subject: Hello\n\nsecond body")))
+
+	// Messages which hash the same with GetMessageHash are not, if their first line differs.
+	require.True(t, insert(hash("Subject:   Hello\n\nfirst body")))
+
+	// The hashing options do not apply to the stub.
+	h.SetHashStructure(true)
+	require.Equal(t, "Subject: Hello", hash("Subject: Hello\n\nfirst body"))
+
+	// The hash error policy still does.
+	_, err := h.HashMessage(nil)
+	require.ErrorIs(t, err, errUnparsable)
+
+	h.SetHashErrorPolicy(HashErrorPolicySendWithoutDedup)
+	require.Empty(t, hash(""))
+}
+
+func TestSendHasher_HashDateBucket(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	lit1 := []byte("Date: Mon, 02 Jan 2006 15:01:00 +0000\r\nTo: a@pm.me\r\n\r\nHello\r\n")
+	lit2 := []byte("Date: Tue, 03 Jan 2006 15:01:00 +0000\r\nTo: a@pm.me\r\n\r\nHello\r\n")
+
+	// Disabled by default, so that retries are deduplicated whatever the window.
+	hash1, err := h.HashMessage(lit1)
+	require.NoError(t, err)
+
+	hash2, err := h.HashMessage(lit2)
+	require.NoError(t, err)
+
+	require.Equal(t, hash1, hash2)
+
+	h.SetHashDateBucket(SendEntryExpiry)
+
+	hash1, err = h.HashMessage(lit1)
+	require.NoError(t, err)
+
+	hash2, err = h.HashMessage(lit2)
+	require.NoError(t, err)
+
+	require.NotEqual(t, hash1, hash2)
+}
+
+func TestSendHasher_ExpiryGrace(t *testing.T) {
+	const grace = time.Second
+
+	start := time.Now()
+
+	// newRecorder returns a recorder holding a sent entry for literal1 which expires one minute after start,
+	// and a function to set its clock.
+	newRecorder := func() (*SendRecorder, func(time.Time)) {
+		now := start
+
+		h := NewSendRecorder(time.Minute, SendMaxEntries)
+		h.now = func() time.Time { return now }
+		h.SetExpiryGrace(grace)
+
+		srID, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+		require.NoError(t, err)
+		require.True(t, ok)
+		h.SignalMessageSent(hash, srID, "abc")
+
+		return h, func(at time.Time) { now = at }
+	}
+
+	exp := start.Add(time.Minute)
+
+	for _, tc := range []struct {
+		name    string
+		now     time.Time
+		expired bool
+	}{
+		{name: "before expiry", now: exp.Add(-time.Millisecond), expired: false},
+		{name: "at expiry", now: exp, expired: false},
+		{name: "within grace", now: exp.Add(grace / 2), expired: false},
+		{name: "end of grace", now: exp.Add(grace), expired: false},
+		{name: "after grace", now: exp.Add(grace + time.Nanosecond), expired: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Looking up the entry and inserting it again agree on whether it is expired.
+			h, setNow := newRecorder()
+			setNow(tc.now)
+
+			_, found, err := testHasEntry(h, literal1, time.Now().Add(time.Second))
+			require.NoError(t, err)
+			require.Equal(t, !tc.expired, found)
+
+			h, setNow = newRecorder()
+			setNow(tc.now)
+
+			_, _, inserted, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+			require.NoError(t, err)
+			require.Equal(t, tc.expired, inserted)
+		})
+	}
+}
+
+func TestSendHasher_ExpiryGraceDisabled(t *testing.T) {
+	now := time.Now()
+
+	h := NewSendRecorder(time.Minute, SendMaxEntries)
+	h.now = func() time.Time { return now }
+	h.SetExpiryGrace(-time.Second)
+
+	srID, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	h.SignalMessageSent(hash, srID, "abc")
+
+	// Without grace, the entry is evicted as soon as it expires.
+	now = now.Add(time.Minute)
+
+	_, found, err := testHasEntry(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, found)
+
+	now = now.Add(time.Nanosecond)
+
+	_, found, err = testHasEntry(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestSendHasher_Forget(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	// Insert a message which is never signaled as sent nor failed.
+	_, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.True(t, h.Forget(hash))
+
+	// The same message can be inserted again immediately, rather than waiting for the stuck send.
+	start := time.Now()
+
+	_, _, ok, err = testTryInsert(h, literal1, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	// Unknown hashes are ignored.
+	require.False(t, h.Forget("unknown"))
+}
+
+func TestSendHasher_Forget_ReleasesWaiters(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	srID1, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// The stuck send is forgotten while an identical message waits for it.
+	forgotCh := make(chan bool, 1)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		forgotCh <- h.Forget(hash)
+	}()
+
+	srID2, _, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotEqual(t, srID1, srID2)
+	require.True(t, <-forgotCh)
+
+	// The forgotten send completing late does not affect the new entry.
+	h.SignalMessageSent(hash, srID1, "abc")
+	require.Equal(t, 1, h.WaitAllInFlight(newTestTimeoutContext(t, 100*time.Millisecond)))
+}
+
+func TestSendHasher_CancelSend(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	srID1, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Other sends are not affected.
+	require.False(t, h.CancelSend(hash, srID1+1))
+
+	// The send is cancelled after the insert but before the message was handed over to the API, while an identical
+	// message waits for it.
+	cancelledCh := make(chan bool, 1)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancelledCh <- h.CancelSend(hash, srID1)
+	}()
+
+	srID2, _, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotEqual(t, srID1, srID2)
+	require.True(t, <-cancelledCh)
+
+	// The cancelled sender must not send the message; the retry may.
+	require.ErrorIs(t, h.CommitSend(hash, srID1), ErrSendCancelled)
+	require.NoError(t, h.CommitSend(hash, srID2))
+
+	// The cancelled sender cleaning up does not affect the retry.
+	h.RemoveOnFail(hash, srID1)
+	h.SignalMessageSent(hash, srID2, "abc")
+
+	_, messageID, ok, err := h.TryInsertWaitGetID(context.Background(), hash, nil, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, "abc", messageID)
+
+	// Unknown hashes are ignored.
+	require.False(t, h.CancelSend("unknown", srID1))
+}
+
+func TestSendHasher_CancelSend_AfterCommit(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	srID, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Once the message is handed over to the API, the send can no longer be cancelled.
+	require.NoError(t, h.CommitSend(hash, srID))
+	require.False(t, h.CancelSend(hash, srID))
+
+	// An identical message waits for its outcome, and is deduplicated.
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		h.SignalMessageSent(hash, srID, "abc")
+	}()
+
+	_, messageID, ok, err := h.TryInsertWaitGetID(context.Background(), hash, nil, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, "abc", messageID)
+
+	// Sent messages are never cancelled.
+	require.False(t, h.CancelSend(hash, srID))
+}
+
+func TestSendHasher_CancelSend_Expired(t *testing.T) {
+	h := NewSendRecorder(time.Second, SendMaxEntries)
+
+	now := time.Now()
+	h.now = func() time.Time { return now }
+
+	srID, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, h.CancelSend(hash, srID))
+
+	// Cancellations are forgotten once the entry would have expired, if the sender never learnt about them.
+	now = now.Add(2 * time.Second)
+	h.removeExpired(context.Background())
+
+	require.Empty(t, h.cancelled)
+	require.NoError(t, h.CommitSend(hash, srID))
+}
+
+// newTestTimeoutContext returns a context which times out after the given duration, or at the end of the test.
+func newTestTimeoutContext(t *testing.T, timeout time.Duration) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancel)
+
+	return ctx
+}
+
+func TestSendHasher_WaitAllInFlight(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	// Nothing is in flight.
+	require.Zero(t, h.WaitAllInFlight(context.Background()))
+
+	srID1, hash1, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	srID2, hash2, ok, err := testTryInsert(h, literal2, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// The sends complete, one successfully and one not, while waiting.
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		h.SignalMessageSent(hash1, srID1, "abc")
+		h.RemoveOnFail(hash2, srID2)
+	}()
+
+	require.Zero(t, h.WaitAllInFlight(context.Background()))
+}
+
+func TestSendHasher_WaitAllInFlight_Timeout(t *testing.T) {
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	srID1, hash1, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	h.SignalMessageSent(hash1, srID1, "abc")
+
+	_, _, ok, err = testTryInsert(h, literal2, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// Only the message which is still being sent is reported.
+	require.Equal(t, 1, h.WaitAllInFlight(ctx))
+}
+
+func TestSendHasher_Shadow(t *testing.T) {
+	hook := newTestLogHook(t)
+
+	h := NewShadowSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	srID1, hash1, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Empty(t, hook.AllEntries())
+
+	// The same message being sent does not block the insert, which would otherwise wait until the deadline.
+	start := time.Now()
+
+	srID2, hash2, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotEqual(t, srID1, srID2)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	// The would-be duplicate is logged.
+	entry := hook.LastEntry()
+	require.NotNil(t, entry)
+	require.Equal(t, logrus.WarnLevel, entry.Level)
+	require.Equal(t, hash2, entry.Data["hash"])
+	require.Equal(t, srID1, entry.Data["priorSrID"])
+	require.IsType(t, time.Time{}, entry.Data["priorExpiry"])
+	require.True(t, entry.Data["priorExpiry"].(time.Time).After(time.Now())) //nolint:forcetypeassert
+	require.Equal(t, false, entry.Data["priorSent"])
+
+	// The same message which was already sent is not deduplicated either.
+	h.SignalMessageSent(hash1, srID1, "abc")
+	hook.Reset()
+
+	_, _, ok, err = testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	entry = hook.LastEntry()
+	require.NotNil(t, entry)
+	require.Equal(t, "abc", entry.Data["priorMsgID"])
+	require.Equal(t, true, entry.Data["priorSent"])
+
+	// Distinct messages are not logged.
+	hook.Reset()
+
+	_, _, ok, err = testTryInsert(h, literal2, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Empty(t, hook.AllEntries())
+
+	require.Zero(t, h.Stats().DedupHits)
+}
+
+func TestSendHasher_ShadowDisabledByDefault(t *testing.T) {
+	hook := newTestLogHook(t)
+
+	h := NewSendRecorder(SendEntryExpiry, SendMaxEntries)
+
+	_, _, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, _, _, err = testTryInsert(h, literal1, time.Now().Add(100*time.Millisecond))
+	require.ErrorIs(t, err, ErrSendWaitTimeout)
+	require.Empty(t, hook.AllEntries())
+}
+
+// newTestLogHook records the entries logged by the standard logger until the end of the test.
+func newTestLogHook(t *testing.T) *logtest.Hook {
+	hooks := logrus.StandardLogger().ReplaceHooks(make(logrus.LevelHooks))
+	t.Cleanup(func() { logrus.StandardLogger().ReplaceHooks(hooks) })
+
+	return logtest.NewGlobal()
 }
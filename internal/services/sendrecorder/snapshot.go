@@ -0,0 +1,62 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// EntrySnapshot describes a send recorder entry for diagnostics. It only holds the opaque message hash and timing
+// information; the recipients and the message ID itself are deliberately left out.
+type EntrySnapshot struct {
+	Hash         string        // The hash of the message.
+	HasMessageID bool          // Whether the message was signaled as sent.
+	ExpiresIn    time.Duration // The time left before the entry expires; negative if it expired and awaits removal.
+}
+
+// Snapshot returns the entries currently held by the recorder, sorted from the soonest to expire.
+func (h *SendRecorder) Snapshot() []EntrySnapshot {
+	h.entriesLock.Lock()
+	defer h.entriesLock.Unlock()
+
+	now := h.now()
+
+	snapshot := make([]EntrySnapshot, 0, len(h.expiries))
+
+	for _, entries := range h.entries {
+		for _, entry := range entries {
+			snapshot = append(snapshot, EntrySnapshot{
+				Hash:         entry.hash,
+				HasMessageID: entry.msgID != "",
+				ExpiresIn:    entry.exp.Sub(now),
+			})
+		}
+	}
+
+	slices.SortFunc(snapshot, func(a, b EntrySnapshot) bool {
+		if a.ExpiresIn != b.ExpiresIn {
+			return a.ExpiresIn < b.ExpiresIn
+		}
+
+		return a.Hash < b.Hash
+	})
+
+	return snapshot
+}
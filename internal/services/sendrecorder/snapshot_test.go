@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendHasher_Snapshot(t *testing.T) {
+	now := time.Now()
+
+	h := NewSendRecorder(time.Minute, SendMaxEntries)
+	h.now = func() time.Time { return now }
+
+	require.Empty(t, h.Snapshot())
+
+	srID1, hash1, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second), "to@pm.me")
+	require.NoError(t, err)
+	require.True(t, ok)
+	h.SignalMessageSent(hash1, srID1, "messageID")
+
+	now = now.Add(10 * time.Second)
+
+	_, hash2, ok, err := testTryInsert(h, literal2, time.Now().Add(time.Second), "other@pm.me")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	now = now.Add(5 * time.Second)
+
+	// The entries are sorted from the soonest to expire, with the time left relative to the recorder clock.
+	require.Equal(t, []EntrySnapshot{
+		{Hash: hash1, HasMessageID: true, ExpiresIn: 45 * time.Second},
+		{Hash: hash2, HasMessageID: false, ExpiresIn: 55 * time.Second},
+	}, h.Snapshot())
+}
+
+func TestSendHasher_SnapshotOmitsSensitiveData(t *testing.T) {
+	h := NewSendRecorder(time.Minute, SendMaxEntries)
+
+	srID, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second), "recipient@pm.me")
+	require.NoError(t, err)
+	require.True(t, ok)
+	h.SignalMessageSent(hash, srID, "secretMessageID")
+
+	snapshot := h.Snapshot()
+	require.Len(t, snapshot, 1)
+
+	dump := fmt.Sprintf("%+v", snapshot)
+	require.False(t, strings.Contains(dump, "recipient@pm.me"))
+	require.False(t, strings.Contains(dump, "secretMessageID"))
+	require.False(t, strings.Contains(dump, "sender@pm.me"))
+	require.False(t, strings.Contains(dump, "Subject"))
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"container/heap"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// PersistentStore persists the entries of messages which were sent, so that duplicate protection survives a restart.
+// Entries are identified by their hash together with the ID of the sent message, as the same message may be sent
+// to different recipients.
+type PersistentStore interface {
+	Save(hash, msgID string, toList []string, exp time.Time) error
+	LoadAll() ([]PersistedEntry, error)
+	Delete(hash, msgID string) error
+}
+
+// PersistedEntry is an entry of a sent message, as loaded from a PersistentStore.
+type PersistedEntry struct {
+	Hash   string
+	MsgID  string
+	ToList []string
+	Exp    time.Time
+}
+
+// NewSendRecorderWithStore is like NewSendRecorder, but entries of sent messages are written through to the given
+// store and the unexpired ones it holds are loaded back.
+func NewSendRecorderWithStore(expiry time.Duration, maxEntries int, store PersistentStore) (*SendRecorder, error) {
+	h := NewSendRecorder(expiry, maxEntries)
+
+	persisted, err := store.LoadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load send recorder entries: %w", err)
+	}
+
+	now := h.now()
+
+	for _, p := range persisted {
+		if h.isExpiredUnsafe(p.Exp, now) {
+			if err := store.Delete(p.Hash, p.MsgID); err != nil {
+				logrus.WithError(err).Warn("Failed to delete expired send recorder entry")
+			}
+
+			continue
+		}
+
+		entry := &sendEntry{
+			hash:         p.Hash,
+			srID:         h.newSendRecorderID(),
+			msgID:        p.MsgID,
+			toList:       p.ToList,
+			exp:          p.Exp,
+			waitCh:       make(chan struct{}),
+			waitChClosed: true,
+		}
+
+		close(entry.waitCh)
+
+		h.entries[p.Hash] = append(h.entries[p.Hash], entry)
+		heap.Push(&h.expiries, entry)
+	}
+
+	h.store = store
+
+	h.evictOverflowUnsafe()
+
+	return h, nil
+}
+
+// persistEntryUnsafe writes the given sent entry to the store, if any.
+func (h *SendRecorder) persistEntryUnsafe(entry *sendEntry) {
+	if h.store == nil {
+		return
+	}
+
+	if err := h.store.Save(entry.hash, entry.msgID, entry.toList, entry.exp); err != nil {
+		logrus.WithError(err).Warn("Failed to persist send recorder entry")
+	}
+}
+
+// unpersistEntryUnsafe deletes the given entry from the store, if any and if it was persisted.
+func (h *SendRecorder) unpersistEntryUnsafe(entry *sendEntry) {
+	if h.store == nil || entry.msgID == "" {
+		return
+	}
+
+	if err := h.store.Delete(entry.hash, entry.msgID); err != nil {
+		logrus.WithError(err).Warn("Failed to delete persisted send recorder entry")
+	}
+}
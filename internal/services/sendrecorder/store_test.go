@@ -0,0 +1,143 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package sendrecorder
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+)
+
+type memoryStore struct {
+	lock    sync.Mutex
+	entries map[string]PersistedEntry
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{entries: make(map[string]PersistedEntry)}
+}
+
+func (s *memoryStore) Save(hash, msgID string, toList []string, exp time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.entries[hash+msgID] = PersistedEntry{Hash: hash, MsgID: msgID, ToList: toList, Exp: exp}
+
+	return nil
+}
+
+func (s *memoryStore) LoadAll() ([]PersistedEntry, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return maps.Values(s.entries), nil
+}
+
+func (s *memoryStore) Delete(hash, msgID string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.entries, hash+msgID)
+
+	return nil
+}
+
+func (s *memoryStore) len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return len(s.entries)
+}
+
+func TestSendHasher_Store_SurvivesRestart(t *testing.T) {
+	store := newMemoryStore()
+
+	h, err := NewSendRecorderWithStore(SendEntryExpiry, SendMaxEntries, store)
+	require.NoError(t, err)
+
+	// Send a message; only the unsent one should not be persisted.
+	srID, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second), "to@pm.me")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	h.SignalMessageSent(hash, srID, "abc")
+
+	_, hash2, ok, err := testTryInsert(h, literal2, time.Now().Add(time.Second), "to@pm.me")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.Equal(t, 1, store.len())
+
+	// Simulate a restart.
+	h, err = NewSendRecorderWithStore(SendEntryExpiry, SendMaxEntries, store)
+	require.NoError(t, err)
+
+	// Sending the same message again should be detected as a duplicate of the original send.
+	_, messageID, ok, err := h.TryInsertWaitGetID(context.Background(), hash, []string{"to@pm.me"}, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, "abc", messageID)
+
+	messageID, ok, err = testHasEntry(h, literal1, time.Now().Add(time.Second), "to@pm.me")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "abc", messageID)
+
+	// The same message to other recipients is not a duplicate.
+	_, _, ok, err = testTryInsert(h, literal1, time.Now().Add(time.Second), "other@pm.me")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// The message which was not sent before the restart can be sent.
+	_, _, ok = h.TryInsert(hash2, []string{"to@pm.me"})
+	require.True(t, ok)
+}
+
+func TestSendHasher_Store_Expiry(t *testing.T) {
+	store := newMemoryStore()
+
+	h, err := NewSendRecorderWithStore(time.Second, SendMaxEntries, store)
+	require.NoError(t, err)
+
+	srID, hash, ok, err := testTryInsert(h, literal1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	h.SignalMessageSent(hash, srID, "abc")
+	require.Equal(t, 1, store.len())
+
+	// Expired entries are deleted from the store when they are pruned...
+	time.Sleep(time.Second + 100*time.Millisecond)
+
+	h.removeExpired(context.Background())
+	require.Zero(t, store.len())
+
+	// ... and are not loaded back after a restart.
+	require.NoError(t, store.Save(hash, "abc", nil, time.Now().Add(-time.Second)))
+
+	h, err = NewSendRecorderWithStore(SendEntryExpiry, SendMaxEntries, store)
+	require.NoError(t, err)
+	require.Zero(t, store.len())
+
+	_, _, ok = h.TryInsert(hash, nil)
+	require.True(t, ok)
+}
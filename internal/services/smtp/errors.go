@@ -26,6 +26,8 @@ var ErrInvalidRecipient = errors.New("invalid recipient")
 var ErrInvalidReturnPath = errors.New("invalid return path")
 var ErrNoSuchUser = errors.New("no such user")
 var ErrTooManyErrors = errors.New("too many failed requests, please try again later")
+var ErrSendTimeout = errors.New("timed out sending message")
+var ErrSendOutcomeUnknown = errors.New("message may have been sent")
 
 type ErrCanNotSendOnAddress struct {
 	address string
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package smtp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// defaultDedupWaitTimeout is how long a send waits for an identical message which is still being sent, before giving
+// up on it.
+const defaultDedupWaitTimeout = 90 * time.Second
+
+// SetDedupWaitTimeout sets how long a send waits for an identical message which is still being sent before giving up
+// and asking the client to try again later. A non-positive timeout selects the default, 90 seconds.
+// It is independent of the send timeout: waiting for a duplicate does not use up the time allowed to send.
+func (s *Service) SetDedupWaitTimeout(timeout time.Duration) {
+	s.dedupWaitTimeout.Store(int64(timeout))
+}
+
+// SetSendTimeout sets how long sending a message to the API may take, once it is known not to be a duplicate.
+// A non-positive timeout, the default, only bounds the send by the SMTP session.
+func (s *Service) SetSendTimeout(timeout time.Duration) {
+	s.sendTimeout.Store(int64(timeout))
+}
+
+// dedupWaitDeadline returns until when a send may wait for an identical message being sent, starting now.
+func (s *Service) dedupWaitDeadline() time.Time {
+	timeout := time.Duration(s.dedupWaitTimeout.Load())
+	if timeout <= 0 {
+		timeout = defaultDedupWaitTimeout
+	}
+
+	return time.Now().Add(timeout)
+}
+
+// withSendTimeout returns the context within which the message is sent to the API.
+func (s *Service) withSendTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if timeout := time.Duration(s.sendTimeout.Load()); timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+
+	return context.WithCancel(ctx)
+}
+
+// sendOutcomeUnknown returns whether a failed send may nevertheless have been sent: the message was handed over to the
+// API, but the send timed out or the SMTP session was cancelled before the API replied.
+func sendOutcomeUnknown(sendCtx context.Context, committed bool) bool {
+	return committed && sendCtx.Err() != nil
+}
+
+// sendTimeoutError returns ErrSendTimeout if the send failed because it exceeded the send timeout rather than because
+// the SMTP session was cancelled, and the error as is otherwise.
+func sendTimeoutError(ctx, sendCtx context.Context, err error) error {
+	if ctx.Err() == nil && errors.Is(sendCtx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %v", ErrSendTimeout, err)
+	}
+
+	return err
+}
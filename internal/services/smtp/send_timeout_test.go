@@ -0,0 +1,131 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package smtp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_DedupWaitTimeout(t *testing.T) {
+	var s Service
+
+	require.WithinDuration(t, time.Now().Add(defaultDedupWaitTimeout), s.dedupWaitDeadline(), time.Second)
+
+	s.SetDedupWaitTimeout(time.Minute)
+	require.WithinDuration(t, time.Now().Add(time.Minute), s.dedupWaitDeadline(), time.Second)
+
+	// The send timeout does not affect the time waited for duplicates.
+	s.SetSendTimeout(time.Hour)
+	require.WithinDuration(t, time.Now().Add(time.Minute), s.dedupWaitDeadline(), time.Second)
+
+	s.SetDedupWaitTimeout(0)
+	require.WithinDuration(t, time.Now().Add(defaultDedupWaitTimeout), s.dedupWaitDeadline(), time.Second)
+}
+
+func TestService_SendTimeout(t *testing.T) {
+	var s Service
+
+	// By default, the send is only bounded by the session context.
+	sendCtx, cancel := s.withSendTimeout(context.Background())
+	defer cancel()
+
+	_, ok := sendCtx.Deadline()
+	require.False(t, ok)
+
+	s.SetSendTimeout(time.Minute)
+
+	sendCtx, cancel = s.withSendTimeout(context.Background())
+	defer cancel()
+
+	deadline, ok := sendCtx.Deadline()
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+
+	// The dedup wait timeout does not affect the send timeout.
+	s.SetDedupWaitTimeout(time.Hour)
+
+	sendCtx, cancel = s.withSendTimeout(context.Background())
+	defer cancel()
+
+	deadline, ok = sendCtx.Deadline()
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+}
+
+func TestSendTimeoutError(t *testing.T) {
+	var s Service
+
+	s.SetSendTimeout(time.Millisecond)
+
+	sendErr := errors.New("failed to send message")
+
+	// The send exceeding its timeout is reported as such.
+	sendCtx, cancel := s.withSendTimeout(context.Background())
+	defer cancel()
+
+	<-sendCtx.Done()
+	require.ErrorIs(t, sendTimeoutError(context.Background(), sendCtx, sendErr), ErrSendTimeout)
+
+	// A cancelled session is not a send timeout.
+	ctx, cancelSession := context.WithCancel(context.Background())
+	cancelSession()
+
+	sendCtx, cancel = s.withSendTimeout(ctx)
+	defer cancel()
+
+	require.Equal(t, sendErr, sendTimeoutError(ctx, sendCtx, sendErr))
+
+	// Nor is a send failing within its timeout.
+	s.SetSendTimeout(time.Minute)
+
+	sendCtx, cancel = s.withSendTimeout(context.Background())
+	defer cancel()
+
+	require.Equal(t, sendErr, sendTimeoutError(context.Background(), sendCtx, sendErr))
+}
+
+func TestSendOutcomeUnknown(t *testing.T) {
+	var s Service
+
+	s.SetSendTimeout(time.Millisecond)
+
+	sendCtx, cancel := s.withSendTimeout(context.Background())
+	defer cancel()
+
+	<-sendCtx.Done()
+
+	// A send timing out before the message was handed over to the API was not sent.
+	require.False(t, sendOutcomeUnknown(sendCtx, false))
+
+	// Once handed over, the API may have sent it before the timeout.
+	require.True(t, sendOutcomeUnknown(sendCtx, true))
+
+	// A cancelled session is treated the same way.
+	ctx, cancelSession := context.WithCancel(context.Background())
+	cancelSession()
+
+	require.True(t, sendOutcomeUnknown(ctx, true))
+
+	// A send rejected by the API within its timeout was not sent.
+	require.False(t, sendOutcomeUnknown(context.Background(), true))
+}
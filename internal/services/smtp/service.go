@@ -22,12 +22,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sync/atomic"
 	"time"
 
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/gluon/logging"
 	"github.com/ProtonMail/gluon/reporter"
 	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	bridgelogging "github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/orderedtasks"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/sendrecorder"
@@ -57,12 +59,17 @@ type Service struct {
 	keyPassProvider    useridentity.KeyPassProvider
 	identityState      *useridentity.State
 	telemetry          Telemetry
+	eventPublisher     events.EventPublisher
 
 	eventService userevents.Subscribable
 	subscription *userevents.EventChanneledSubscriber
 
 	addressMode   usertypes.AddressMode
 	serverManager ServerManager
+
+	// dedupWaitTimeout and sendTimeout are durations in nanoseconds; see SetDedupWaitTimeout and SetSendTimeout.
+	dedupWaitTimeout atomic.Int64
+	sendTimeout      atomic.Int64
 }
 
 func NewService(
@@ -74,6 +81,7 @@ func NewService(
 	bridgePassProvider useridentity.BridgePassProvider,
 	keyPassProvider useridentity.KeyPassProvider,
 	telemetry Telemetry,
+	eventPublisher events.EventPublisher,
 	eventService userevents.Subscribable,
 	mode usertypes.AddressMode,
 	identityState *useridentity.State,
@@ -81,7 +89,7 @@ func NewService(
 ) *Service {
 	subscriberName := fmt.Sprintf("smpt-%v", userID)
 
-	return &Service{
+	s := &Service{
 		panicHandler: handler,
 		userID:       userID,
 		cpc:          cpc.NewCPC(),
@@ -96,6 +104,7 @@ func NewService(
 		bridgePassProvider: bridgePassProvider,
 		keyPassProvider:    keyPassProvider,
 		telemetry:          telemetry,
+		eventPublisher:     eventPublisher,
 		identityState:      identityState,
 		eventService:       eventService,
 
@@ -104,10 +113,25 @@ func NewService(
 		addressMode:   mode,
 		serverManager: serverManager,
 	}
+
+	recorder.SetDecisionHook(s.logDedupDecision)
+
+	return s
+}
+
+// logDedupDecision logs whether the send recorder considered a message as new or as a duplicate, to help investigate
+// duplicate deliveries.
+func (s *Service) logDedupDecision(hash string, inserted, waited bool) {
+	s.log.WithFields(logrus.Fields{
+		"hash":     hash,
+		"inserted": inserted,
+		"waited":   waited,
+	}).Debug("Send recorder decision")
 }
 
 func (s *Service) SendMail(ctx context.Context, authID string, from string, to []string, r io.Reader) error {
 	_, err := s.cpc.Send(ctx, &sendMailReq{
+		ctx:    ctx,
 		authID: authID,
 		from:   from,
 		to:     to,
@@ -247,6 +271,8 @@ func (s *Service) run(ctx context.Context) {
 }
 
 type sendMailReq struct {
+	// ctx is the context of the SMTP session; the send is given up if it is cancelled.
+	ctx    context.Context
 	authID string
 	from   string
 	to     []string
@@ -262,6 +288,19 @@ func (s *Service) sendMail(ctx context.Context, req *sendMailReq) error {
 		s.log.Debugf("Send mail request finished in %v", end.Sub(start))
 	}()
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		defer async.HandlePanic(s.panicHandler)
+
+		select {
+		case <-req.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
 	if err := s.smtpSendMail(ctx, req.authID, req.from, req.to, req.r); err != nil {
 		if apiErr := new(proton.APIError); errors.As(err, &apiErr) {
 			s.log.WithError(apiErr).WithField("Details", apiErr.DetailsToString()).Error("failed to send message")
@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"mime"
@@ -35,6 +36,7 @@ import (
 	"github.com/ProtonMail/gluon/rfc822"
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/sendrecorder"
 	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
@@ -48,6 +50,8 @@ import (
 
 // smtpSendMail sends an email from the given address to the given recipients.
 func (s *Service) smtpSendMail(ctx context.Context, authID string, from string, to []string, r io.Reader) error {
+	start := time.Now()
+
 	fromAddr, err := s.identityState.GetAddr(from)
 	if err != nil {
 		return ErrInvalidReturnPath
@@ -69,21 +73,38 @@ func (s *Service) smtpSendMail(ctx context.Context, authID string, from string,
 	}
 
 	// Compute the hash of the message (to match it against SMTP messages).
-	hash, err := sendrecorder.GetMessageHash(b)
+	hashStart := time.Now()
+	hash, err := s.recorder.HashMessage(b)
 	if err != nil {
 		return err
 	}
+	hashDuration := time.Since(hashStart)
+
+	// The idempotency key is only meant for the duplicate check; it is not sent.
+	b = sendrecorder.StripIdempotencyKey(b)
 
 	// Check if we already tried to send this message recently.
-	s.log.Debug("Checking for duplicate message")
-	srID, ok, err := s.recorder.TryInsertWait(ctx, hash, to, time.Now().Add(90*time.Second))
-	if err != nil {
-		return fmt.Errorf("failed to check send hash: %w", err)
-	} else if !ok {
-		s.log.Warn("A duplicate message was already sent recently, skipping")
-		return nil
+	// An empty hash means the message could not be hashed and is sent without duplicate protection.
+	var srID sendrecorder.ID
+	if hash != "" {
+		id, ok, err := s.checkDuplicate(ctx, hash, to, b)
+		if err != nil {
+			return err
+		} else if !ok {
+			return nil
+		}
+
+		srID = id
+
+		defer s.cancelSendOnDone(ctx, hash, srID)()
+	} else if err := s.recorder.WaitSendAllowed(ctx, s.dedupWaitDeadline()); err != nil {
+		return fmt.Errorf("failed to wait for send to be allowed: %w", err)
 	}
 
+	// The time spent waiting for a duplicate above does not count towards the send timeout.
+	sendCtx, cancel := s.withSendTimeout(ctx)
+	defer cancel()
+
 	// Create a new message parser from the reader.
 	parser, err := parser.New(bytes.NewReader(b))
 	if err != nil {
@@ -108,13 +129,18 @@ func (s *Service) smtpSendMail(ctx context.Context, authID string, from string,
 	}
 
 	// Load the user's mail settings.
-	settings, err := s.client.GetMailSettings(ctx)
+	settings, err := s.client.GetMailSettings(sendCtx)
 	if err != nil {
 		s.log.Debug("Message failed to send, removing from send recorder")
 		s.recorder.RemoveOnFail(hash, srID)
-		return fmt.Errorf("failed to get mail settings: %w", err)
+		return sendTimeoutError(ctx, sendCtx, fmt.Errorf("failed to get mail settings: %w", err))
 	}
 
+	var sentID, sentFrom string
+
+	// committed is whether the message was handed over to the API, after which it may be sent even if the send fails.
+	var committed bool
+
 	if err := usertypes.WithAddrKR(s.identityState.User, fromAddr, s.keyPassProvider.KeyPass(), func(userKR, addrKR *crypto.KeyRing) error {
 		// Use the first key for encrypting the message.
 		addrKR, err := addrKR.FirstKey()
@@ -149,13 +175,22 @@ func (s *Service) smtpSendMail(ctx context.Context, authID string, from string,
 
 		// Send the message using the correct key.
 		sent, err := s.sendWithKey(
-			ctx,
+			sendCtx,
 			authID,
 			s.addressMode,
 			settings,
 			userKR, addrKR,
 			emails, from, to,
 			message,
+			func() error {
+				if err := s.recorder.CommitSend(hash, srID); err != nil {
+					return err
+				}
+
+				committed = true
+
+				return nil
+			},
 		)
 		if err != nil {
 			return fmt.Errorf("failed to send message: %w", err)
@@ -164,14 +199,42 @@ func (s *Service) smtpSendMail(ctx context.Context, authID string, from string,
 		// If the message was successfully sent, we can update the message ID in the record.
 		s.log.Debug("Message sent successfully, signaling recorder")
 		s.recorder.SignalMessageSent(hash, srID, sent.ID)
+		sentID = sent.ID
+
+		if message.Sender != nil {
+			sentFrom = message.Sender.Address
+		} else {
+			sentFrom = fromAddr.Email
+		}
 
 		return nil
 	}); err != nil {
+		// The message may have been sent: keep it in the send recorder until it expires, so that identical messages are
+		// not sent again in the meantime, and tell the client not to retry.
+		if sendOutcomeUnknown(sendCtx, committed) {
+			s.log.WithError(err).Warn("Message may have been sent, keeping it in send recorder")
+			return fmt.Errorf("%w: %v", ErrSendOutcomeUnknown, err)
+		}
+
 		s.log.Debug("Message failed to send, removing from send recorder")
 		s.recorder.RemoveOnFail(hash, srID)
-		return err
+		return sendTimeoutError(ctx, sendCtx, err)
 	}
 
+	s.eventPublisher.PublishEvent(ctx, events.SendCompleted{
+		UserID:       s.userID,
+		MessageID:    sentID,
+		Duration:     time.Since(start),
+		HashDuration: hashDuration,
+		Size:         len(b),
+	})
+
+	s.eventPublisher.PublishEvent(ctx, events.SentFromAddress{
+		UserID:      s.userID,
+		MessageID:   sentID,
+		FromAddress: sentFrom,
+	})
+
 	return nil
 }
 
@@ -186,6 +249,7 @@ func (s *Service) sendWithKey(
 	from string,
 	to []string,
 	message message.Message,
+	commitSend func() error,
 ) (proton.Message, error) {
 	references := message.References
 	if message.InReplyTo != "" {
@@ -248,6 +312,11 @@ func (s *Service) sendWithKey(
 		return proton.Message{}, fmt.Errorf("failed to create packages: %w", err)
 	}
 
+	// The send may have been cancelled while the draft was being prepared; it can no longer be once committed.
+	if err := commitSend(); err != nil {
+		return proton.Message{}, err
+	}
+
 	res, err := s.client.SendDraft(ctx, draft.ID, req)
 	if err != nil {
 		return proton.Message{}, fmt.Errorf("failed to send draft: %w", err)
@@ -570,6 +639,78 @@ func getContactSettings(
 	return contact.GetSettings(userKR, recipient, proton.CardTypeSigned)
 }
 
+// checkDuplicate records the message with the given hash in the send recorder, waiting for any identical message
+// still being sent. It returns false if the message is a duplicate of one sent recently and must not be sent again.
+// cancelSendOnDone cancels the given send in the send recorder if the context is done before the message is handed
+// over to the API, e.g. because the SMTP session was closed, so that the message is not sent behind the client's back
+// and identical retries do not wait for it. The returned function stops watching the context.
+func (s *Service) cancelSendOnDone(ctx context.Context, hash string, srID sendrecorder.ID) func() {
+	doneCh := make(chan struct{})
+
+	go func() {
+		defer async.HandlePanic(s.panicHandler)
+
+		select {
+		case <-ctx.Done():
+			if s.recorder.CancelSend(hash, srID) {
+				s.log.Info("Send was given up before the message was sent, cancelled it")
+			}
+
+		case <-doneCh:
+		}
+	}()
+
+	return func() { close(doneCh) }
+}
+
+func (s *Service) checkDuplicate(ctx context.Context, hash string, to []string, b []byte) (sendrecorder.ID, bool, error) {
+	s.log.Debug("Checking for duplicate message")
+
+	id, sentID, ok, err := s.recorder.TryInsertWaitGetID(ctx, hash, to, s.dedupWaitDeadline())
+	if errors.Is(err, sendrecorder.ErrPriorSendInProgress) {
+		s.log.Warn("An identical message is still being sent, asking to try again later")
+		s.eventPublisher.PublishEvent(ctx, events.SendPriorInProgress{UserID: s.userID})
+
+		return 0, false, err
+	} else if err != nil {
+		return 0, false, fmt.Errorf("failed to check send hash: %w", err)
+	} else if !ok {
+		s.log.WithField("messageID", sentID).Warn("A duplicate message was already sent recently, skipping")
+
+		var recipient string
+		if len(to) > 0 {
+			recipient = to[0]
+		}
+
+		s.eventPublisher.PublishEvent(ctx, events.SendDeduped{
+			UserID:    s.userID,
+			MessageID: sentID,
+			Subject:   getMessageSubject(b),
+			Recipient: recipient,
+		})
+
+		return 0, false, nil
+	}
+
+	return id, true, nil
+}
+
+// getMessageSubject returns the decoded subject of the given message, or an empty string if it can't be read.
+func getMessageSubject(b []byte) string {
+	header, err := rfc822.Parse(b).ParseHeader()
+	if err != nil {
+		return ""
+	}
+
+	subject := header.Get("Subject")
+
+	if decoded, err := new(mime.WordDecoder).DecodeHeader(subject); err == nil {
+		return decoded
+	}
+
+	return subject
+}
+
 func getMessageSender(parser *parser.Parser) (string, bool) {
 	address, err := rfc5322.ParseAddressList(parser.Root().Header.Get("From"))
 	if err != nil {
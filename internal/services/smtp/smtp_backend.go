@@ -23,8 +23,10 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/identifier"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/sendrecorder"
 	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
 	"github.com/emersion/go-smtp"
 	"github.com/sirupsen/logrus"
@@ -51,6 +53,10 @@ type smtpSession struct {
 
 	from string
 	to   []string
+
+	// cancelData cancels the message being sent, if any.
+	cancelData     context.CancelFunc
+	cancelDataLock sync.Mutex
 }
 
 func (be *Backend) NewSession(*smtp.Conn) (smtp.Session, error) {
@@ -81,7 +87,15 @@ func (s *smtpSession) AuthPlain(username, password string) error {
 	return nil
 }
 
+// Reset may be called while a message is being sent, e.g. if the connection is closed, in which case the send is
+// cancelled unless the message was already handed over to the API.
 func (s *smtpSession) Reset() {
+	s.cancelDataLock.Lock()
+	if s.cancelData != nil {
+		s.cancelData()
+	}
+	s.cancelDataLock.Unlock()
+
 	s.from = ""
 	s.to = nil
 }
@@ -105,11 +119,64 @@ func (s *smtpSession) Rcpt(to string) error {
 }
 
 func (s *smtpSession) Data(r io.Reader) error {
-	err := s.accounts.SendMail(context.Background(), s.userID, s.authID, s.from, s.to, r)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.cancelDataLock.Lock()
+	s.cancelData = cancel
+	s.cancelDataLock.Unlock()
+
+	defer func() {
+		s.cancelDataLock.Lock()
+		s.cancelData = nil
+		s.cancelDataLock.Unlock()
+	}()
+
+	err := s.accounts.SendMail(ctx, s.userID, s.authID, s.from, s.to, r)
 
 	if err != nil {
 		logrus.WithField("pkg", "smtp").WithError(err).Error("Send mail failed.")
 	}
 
+	if errors.Is(err, sendrecorder.ErrPriorSendInProgress) {
+		return &smtp.SMTPError{
+			Code:         451,
+			EnhancedCode: smtp.EnhancedCode{4, 3, 0},
+			Message:      "An identical message is still being sent, please try again later",
+		}
+	}
+
+	if errors.Is(err, sendrecorder.ErrSendWaitTimeout) {
+		return &smtp.SMTPError{
+			Code:         451,
+			EnhancedCode: smtp.EnhancedCode{4, 3, 0},
+			Message:      "Timed out waiting for an identical message being sent, please try again later",
+		}
+	}
+
+	if errors.Is(err, sendrecorder.ErrSendRateLimited) {
+		return &smtp.SMTPError{
+			Code:         451,
+			EnhancedCode: smtp.EnhancedCode{4, 7, 0},
+			Message:      "Too many messages sent recently, please try again later",
+		}
+	}
+
+	if errors.Is(err, ErrSendOutcomeUnknown) {
+		return &smtp.SMTPError{
+			Code:         554,
+			EnhancedCode: smtp.EnhancedCode{5, 4, 7},
+			Message:      "Timed out sending the message, it may have been sent; check the Sent folder before sending it again",
+		}
+	}
+
+	if errors.Is(err, ErrSendTimeout) {
+		return &smtp.SMTPError{
+			Code:         451,
+			EnhancedCode: smtp.EnhancedCode{4, 4, 2},
+			Message:      "Timed out sending the message, please try again later",
+		}
+	}
+
 	return err
 }
@@ -0,0 +1,122 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package smtp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events/mocks"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/sendrecorder"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+const dedupTestMessage = "From: from@pm.me\r\nTo: to@pm.me\r\nSubject: =?UTF-8?q?Caf=C3=A9?=\r\n\r\nHello\r\n"
+
+func TestService_CheckDuplicate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	publisher := mocks.NewMockEventPublisher(mockCtrl)
+
+	s := &Service{
+		userID:         "userID",
+		recorder:       sendrecorder.NewSendRecorder(sendrecorder.SendEntryExpiry, 100),
+		eventPublisher: publisher,
+		log:            logrus.WithField("pkg", "smtp"),
+	}
+
+	s.SetDedupWaitTimeout(time.Second)
+
+	to := []string{"to@pm.me", "cc@pm.me"}
+
+	hash, err := s.recorder.HashMessage([]byte(dedupTestMessage))
+	require.NoError(t, err)
+
+	// A fresh send is not reported: the mock fails on any unexpected event.
+	srID, ok, err := s.checkDuplicate(context.Background(), hash, to, []byte(dedupTestMessage))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	s.recorder.SignalMessageSent(hash, srID, "messageID")
+
+	publisher.EXPECT().PublishEvent(gomock.Any(), events.SendDeduped{
+		UserID:    "userID",
+		MessageID: "messageID",
+		Subject:   "Café",
+		Recipient: "to@pm.me",
+	})
+
+	_, ok, err = s.checkDuplicate(context.Background(), hash, to, []byte(dedupTestMessage))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestService_CancelSendOnDone(t *testing.T) {
+	s := &Service{
+		recorder: sendrecorder.NewSendRecorder(sendrecorder.SendEntryExpiry, 100),
+		log:      logrus.WithField("pkg", "smtp"),
+	}
+
+	s.SetDedupWaitTimeout(time.Second)
+
+	hash, err := s.recorder.HashMessage([]byte(dedupTestMessage))
+	require.NoError(t, err)
+
+	// A send given up before it is committed is cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+
+	srID, ok, err := s.checkDuplicate(ctx, hash, nil, []byte(dedupTestMessage))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	stop := s.cancelSendOnDone(ctx, hash, srID)
+	defer stop()
+
+	cancel()
+
+	// An identical message no longer waits for it.
+	retryID, ok, err := s.checkDuplicate(context.Background(), hash, nil, []byte(dedupTestMessage))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.ErrorIs(t, s.recorder.CommitSend(hash, srID), sendrecorder.ErrSendCancelled)
+
+	// A committed send is not cancelled.
+	ctx, cancel = context.WithCancel(context.Background())
+
+	srID = retryID
+
+	stop = s.cancelSendOnDone(ctx, hash, srID)
+	defer stop()
+
+	require.NoError(t, s.recorder.CommitSend(hash, srID))
+
+	cancel()
+
+	require.Never(t, func() bool {
+		return s.recorder.CancelSend(hash, srID)
+	}, 100*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestGetMessageSubject(t *testing.T) {
+	require.Equal(t, "Café", getMessageSubject([]byte(dedupTestMessage)))
+	require.Equal(t, "Plain", getMessageSubject([]byte("Subject: Plain\r\n\r\nBody\r\n")))
+	require.Equal(t, "", getMessageSubject([]byte("To: to@pm.me\r\n\r\nBody\r\n")))
+}
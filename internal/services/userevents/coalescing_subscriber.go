@@ -0,0 +1,159 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package userevents
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/sirupsen/logrus"
+)
+
+// CoalescingSubscriber wraps a subscriber of message events, accumulating the batches published within a short window
+// and handing them over to the wrapped subscriber as a single batch, to save the overhead of handling many small
+// batches, e.g. while catching up on events.
+// The merged batch keeps the events in order, with a single event per message: the last one, at the position of the
+// first one. An update of a message created in the same batch is merged into its creation, and a flags update into a
+// full update, which also updates the flags.
+// Batches are considered delivered once accumulated; if the wrapped subscriber fails to handle a merged batch, the
+// next batch is rejected with its error, so that the failure reaches the publisher.
+// Closing the subscriber delivers the pending batch first; batches published afterwards are rejected.
+type CoalescingSubscriber struct {
+	wrapped subscriber[[]proton.MessageEvent]
+	window  time.Duration
+
+	// pending is the merged batch not yet delivered, where pendingIdx gives the index of the event of each message.
+	pending    []proton.MessageEvent
+	pendingIdx map[string]int
+	timer      *time.Timer
+	lock       sync.Mutex
+
+	// failure is the error of the wrapped subscriber on the last merged batch, not yet reported to the publisher.
+	failure error
+
+	closed bool
+
+	// deliverLock ensures merged batches are handed over one at a time, in order.
+	deliverLock sync.Mutex
+}
+
+// NewCoalescingSubscriber wraps the given subscriber, delivering it the message events published within each window
+// as a single batch.
+func NewCoalescingSubscriber(wrapped subscriber[[]proton.MessageEvent], window time.Duration) *CoalescingSubscriber {
+	return &CoalescingSubscriber{
+		wrapped: wrapped,
+		window:  window,
+	}
+}
+
+func (c *CoalescingSubscriber) name() string { //nolint:unused
+	return c.wrapped.name()
+}
+
+func (c *CoalescingSubscriber) handle(_ context.Context, events []proton.MessageEvent) error { //nolint:unused
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.closed {
+		return ErrSubscriberCancelled
+	}
+
+	if err := c.failure; err != nil {
+		c.failure = nil
+		return fmt.Errorf("failed to handle coalesced message events: %w", err)
+	}
+
+	if c.pendingIdx == nil {
+		c.pendingIdx = make(map[string]int)
+	}
+
+	for _, event := range events {
+		idx, ok := c.pendingIdx[event.ID]
+		if !ok {
+			c.pendingIdx[event.ID] = len(c.pending)
+			c.pending = append(c.pending, event)
+
+			continue
+		}
+
+		switch prev := c.pending[idx].Action; {
+		case prev == proton.EventCreate && event.Action != proton.EventDelete:
+			event.Action = proton.EventCreate
+
+		case prev == proton.EventUpdate && event.Action == proton.EventUpdateFlags:
+			// Only full updates re-apply the content of drafts and sent messages.
+			event.Action = proton.EventUpdate
+		}
+
+		c.pending[idx] = event
+	}
+
+	if c.timer == nil && len(c.pending) > 0 {
+		c.timer = time.AfterFunc(c.window, c.flush)
+	}
+
+	return nil
+}
+
+// flush hands the pending batch over to the wrapped subscriber.
+func (c *CoalescingSubscriber) flush() {
+	c.deliverLock.Lock()
+	defer c.deliverLock.Unlock()
+
+	c.lock.Lock()
+	batch := c.pending
+	c.pending, c.pendingIdx = nil, nil
+
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+	c.lock.Unlock()
+
+	if len(batch) == 0 {
+		return
+	}
+
+	if err := c.wrapped.handle(context.Background(), batch); err != nil {
+		logrus.WithError(err).WithField("subscriber", c.wrapped.name()).Error("Failed to handle coalesced message events")
+
+		c.lock.Lock()
+		c.failure = err
+		c.lock.Unlock()
+	}
+}
+
+func (c *CoalescingSubscriber) ready() bool { //nolint:unused
+	return isSubscriberReady(c.wrapped)
+}
+
+func (c *CoalescingSubscriber) cancel(ctx context.Context) { //nolint:unused
+	c.wrapped.cancel(ctx)
+}
+
+func (c *CoalescingSubscriber) close() { //nolint:unused
+	c.lock.Lock()
+	c.closed = true
+	c.lock.Unlock()
+
+	c.flush()
+	c.wrapped.close()
+}
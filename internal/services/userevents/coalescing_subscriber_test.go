@@ -0,0 +1,170 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package userevents
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoalescingSubscriber(t *testing.T) {
+	wrapped := &recordingBatchSubscriber{id: "messages"}
+	subscriber := NewCoalescingSubscriber(wrapped, 100*time.Millisecond)
+
+	// Several batches are published within the window.
+	require.NoError(t, subscriber.handle(context.Background(), []proton.MessageEvent{
+		newTestMessageEvent("1", proton.EventCreate, "first"),
+		newTestMessageEvent("2", proton.EventUpdate, "first"),
+	}))
+	require.NoError(t, subscriber.handle(context.Background(), []proton.MessageEvent{
+		newTestMessageEvent("3", proton.EventCreate, "first"),
+		newTestMessageEvent("2", proton.EventUpdateFlags, "second"),
+	}))
+	require.NoError(t, subscriber.handle(context.Background(), []proton.MessageEvent{
+		newTestMessageEvent("1", proton.EventUpdate, "second"),
+		newTestMessageEvent("3", proton.EventDelete, ""),
+	}))
+
+	require.Empty(t, wrapped.received())
+
+	// They are handed over as a single batch, in order, with the last event of each message. The flags update does not
+	// downgrade the full update.
+	require.Eventually(t, func() bool { return len(wrapped.received()) == 1 }, time.Second, time.Millisecond)
+	require.Equal(t, []proton.MessageEvent{
+		newTestMessageEvent("1", proton.EventCreate, "second"),
+		newTestMessageEvent("2", proton.EventUpdate, "second"),
+		newTestMessageEvent("3", proton.EventDelete, ""),
+	}, wrapped.received()[0])
+
+	// Later batches start a new window.
+	require.NoError(t, subscriber.handle(context.Background(), []proton.MessageEvent{
+		newTestMessageEvent("4", proton.EventCreate, "first"),
+	}))
+
+	require.Eventually(t, func() bool { return len(wrapped.received()) == 2 }, time.Second, time.Millisecond)
+	require.Equal(t, []proton.MessageEvent{newTestMessageEvent("4", proton.EventCreate, "first")}, wrapped.received()[1])
+}
+
+func TestCoalescingSubscriber_FlushOnClose(t *testing.T) {
+	wrapped := &recordingBatchSubscriber{id: "messages"}
+	subscriber := NewCoalescingSubscriber(wrapped, time.Hour)
+
+	require.NoError(t, subscriber.handle(context.Background(), []proton.MessageEvent{
+		newTestMessageEvent("1", proton.EventCreate, "first"),
+	}))
+	require.NoError(t, subscriber.handle(context.Background(), []proton.MessageEvent{
+		newTestMessageEvent("2", proton.EventCreate, "first"),
+	}))
+
+	// The pending batch is delivered before the wrapped subscriber is closed.
+	subscriber.close()
+
+	require.Equal(t, [][]proton.MessageEvent{{
+		newTestMessageEvent("1", proton.EventCreate, "first"),
+		newTestMessageEvent("2", proton.EventCreate, "first"),
+	}}, wrapped.received())
+	require.True(t, wrapped.closed)
+
+	// Batches published once closed are rejected.
+	require.ErrorIs(t, subscriber.handle(context.Background(), []proton.MessageEvent{
+		newTestMessageEvent("3", proton.EventCreate, "first"),
+	}), ErrSubscriberCancelled)
+	require.Len(t, wrapped.received(), 1)
+}
+
+func TestCoalescingSubscriber_FailureIsReported(t *testing.T) {
+	wrapped := &recordingBatchSubscriber{id: "messages", err: errors.New("failed")}
+	subscriber := NewCoalescingSubscriber(wrapped, 10*time.Millisecond)
+
+	require.NoError(t, subscriber.handle(context.Background(), []proton.MessageEvent{
+		newTestMessageEvent("1", proton.EventCreate, "first"),
+	}))
+
+	require.Eventually(t, func() bool {
+		subscriber.lock.Lock()
+		defer subscriber.lock.Unlock()
+
+		return subscriber.failure != nil
+	}, time.Second, time.Millisecond)
+
+	// The next batch is rejected with the failure of the previous one, which is only reported once.
+	batch := []proton.MessageEvent{newTestMessageEvent("2", proton.EventCreate, "first")}
+
+	require.ErrorIs(t, subscriber.handle(context.Background(), batch), wrapped.err)
+	require.NoError(t, subscriber.handle(context.Background(), batch))
+
+	subscriber.close()
+
+	require.Equal(t, [][]proton.MessageEvent{
+		{newTestMessageEvent("1", proton.EventCreate, "first")},
+		batch,
+	}, wrapped.received())
+}
+
+func newTestMessageEvent(id string, action proton.EventAction, subject string) proton.MessageEvent {
+	return proton.MessageEvent{
+		EventItem: proton.EventItem{ID: id, Action: action},
+		Message:   proton.MessageMetadata{ID: id, Subject: subject},
+	}
+}
+
+// recordingBatchSubscriber records the batches of message events it handles.
+type recordingBatchSubscriber struct {
+	id     string
+	err    error
+	closed bool
+
+	lock    sync.Mutex
+	batches [][]proton.MessageEvent
+}
+
+func (r *recordingBatchSubscriber) received() [][]proton.MessageEvent {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.batches
+}
+
+func (r *recordingBatchSubscriber) name() string {
+	return r.id
+}
+
+func (r *recordingBatchSubscriber) handle(_ context.Context, events []proton.MessageEvent) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.batches = append(r.batches, events)
+
+	if r.err != nil {
+		return r.err
+	}
+
+	return nil
+}
+
+func (r *recordingBatchSubscriber) cancel(context.Context) {}
+
+func (r *recordingBatchSubscriber) close() {
+	r.closed = true
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package userevents
+
+import (
+	"context"
+	"time"
+)
+
+// FilterSubscriber wraps a subscriber, handing it over only the part of each event it is interested in. The filter
+// returns the reduced event, and false if nothing of interest remains, in which case the wrapped subscriber is not
+// called at all.
+type FilterSubscriber[T any] struct {
+	wrapped subscriber[T]
+	filter  func(T) (T, bool)
+}
+
+// NewFilterSubscriber wraps the given subscriber, filtering the events with the given function before handing them
+// over.
+func NewFilterSubscriber[T any](wrapped subscriber[T], filter func(T) (T, bool)) *FilterSubscriber[T] {
+	return &FilterSubscriber[T]{
+		wrapped: wrapped,
+		filter:  filter,
+	}
+}
+
+func (f *FilterSubscriber[T]) name() string { //nolint:unused
+	return f.wrapped.name()
+}
+
+func (f *FilterSubscriber[T]) handle(ctx context.Context, event T) error { //nolint:unused
+	event, ok := f.filter(event)
+	if !ok {
+		return nil
+	}
+
+	return f.wrapped.handle(ctx, event)
+}
+
+func (f *FilterSubscriber[T]) ready() bool { //nolint:unused
+	return isSubscriberReady(f.wrapped)
+}
+
+func (f *FilterSubscriber[T]) timeout() time.Duration { //nolint:unused
+	if r, ok := f.wrapped.(timeoutReporter); ok {
+		return r.timeout()
+	}
+
+	return 0
+}
+
+func (f *FilterSubscriber[T]) cancel(ctx context.Context) { //nolint:unused
+	f.wrapped.cancel(ctx)
+}
+
+func (f *FilterSubscriber[T]) close() { //nolint:unused
+	f.wrapped.close()
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package userevents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterSubscriber_DropsEverything(t *testing.T) {
+	wrapped := newRecordingSubscriber("test")
+	wrapped.onStart = func() { t.Error("the wrapped subscriber should not be called") }
+
+	subscriber := NewFilterSubscriber[int](wrapped, func(int) (int, bool) { return 0, false })
+
+	list := subscriberList[int]{}
+	list.Add(subscriber)
+
+	require.NoError(t, list.Publish(context.Background(), 1))
+	require.NoError(t, list.Publish(context.Background(), 2))
+
+	require.Empty(t, wrapped.received())
+}
+
+func TestFilterSubscriber_ReducesSlice(t *testing.T) {
+	wrapped := &recordingBatchSubscriber{id: "messages"}
+
+	// Only the creations are of interest.
+	onlyCreations := func(events []proton.MessageEvent) ([]proton.MessageEvent, bool) {
+		events = xslices.Filter(events, func(event proton.MessageEvent) bool {
+			return event.Action == proton.EventCreate
+		})
+
+		return events, len(events) > 0
+	}
+
+	subscriber := NewFilterSubscriber[[]proton.MessageEvent](wrapped, onlyCreations)
+
+	require.NoError(t, subscriber.handle(context.Background(), []proton.MessageEvent{
+		newTestMessageEvent("1", proton.EventCreate, "first"),
+		newTestMessageEvent("2", proton.EventUpdate, "first"),
+		newTestMessageEvent("3", proton.EventCreate, "first"),
+	}))
+
+	// A batch without creations is not handed over at all.
+	require.NoError(t, subscriber.handle(context.Background(), []proton.MessageEvent{
+		newTestMessageEvent("1", proton.EventDelete, ""),
+	}))
+
+	require.Equal(t, [][]proton.MessageEvent{{
+		newTestMessageEvent("1", proton.EventCreate, "first"),
+		newTestMessageEvent("3", proton.EventCreate, "first"),
+	}}, wrapped.received())
+}
+
+func TestFilterSubscriber_ForwardsTimeout(t *testing.T) {
+	wrapped := newRecordingSubscriber("test")
+	wrapped.handleTimeout = time.Second
+
+	subscriber := NewFilterSubscriber[int](wrapped, func(event int) (int, bool) { return event, true })
+
+	require.Equal(t, time.Second, subscriber.timeout())
+	require.Equal(t, "test", subscriber.name())
+}
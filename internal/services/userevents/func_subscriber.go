@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package userevents
+
+import "context"
+
+// FuncSubscriber is a subscriber which hands the events over to a function, on the publishing goroutine and within the
+// publish context. It allows other packages to provide their own subscriber implementation, e.g. in tests.
+type FuncSubscriber[T any] struct {
+	id string
+	fn func(context.Context, T) error
+}
+
+// NewFuncSubscriber creates a subscriber which handles the events with the given function.
+func NewFuncSubscriber[T any](name string, fn func(context.Context, T) error) *FuncSubscriber[T] {
+	return &FuncSubscriber[T]{
+		id: name,
+		fn: fn,
+	}
+}
+
+func (f *FuncSubscriber[T]) name() string { //nolint:unused
+	return f.id
+}
+
+func (f *FuncSubscriber[T]) handle(ctx context.Context, event T) error { //nolint:unused
+	return f.fn(ctx, event)
+}
+
+func (f *FuncSubscriber[T]) cancel(_ context.Context) { //nolint:unused
+	// Nothing to do, the events are not queued.
+}
+
+func (f *FuncSubscriber[T]) close() { //nolint:unused
+	// Nothing to do.
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package userevents
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/google/uuid"
+)
+
+// InjectedEventType is the type of a synthetic event published by Service.InjectEvent.
+type InjectedEventType int
+
+const (
+	InjectedMessageEvents  InjectedEventType = iota // The payload is a []proton.MessageEvent.
+	InjectedLabelEvents                             // The payload is a []proton.LabelEvent.
+	InjectedAddressEvents                           // The payload is a []proton.AddressEvent.
+	InjectedUserEvent                               // The payload is a proton.User.
+	InjectedUsedSpaceEvent                          // The payload is the used space, as an int64.
+	InjectedRefreshEvent                            // The payload is a proton.RefreshFlag.
+)
+
+func (t InjectedEventType) String() string {
+	switch t {
+	case InjectedMessageEvents:
+		return "message"
+	case InjectedLabelEvents:
+		return "label"
+	case InjectedAddressEvents:
+		return "address"
+	case InjectedUserEvent:
+		return "user"
+	case InjectedUsedSpaceEvent:
+		return "used space"
+	case InjectedRefreshEvent:
+		return "refresh"
+	default:
+		return fmt.Sprintf("unknown (%d)", int(t))
+	}
+}
+
+// ErrInvalidInjectedEvent is returned when the payload of an injected event does not match its type.
+var ErrInvalidInjectedEvent = errors.New("invalid injected event")
+
+// newInjectedEvent builds the event carrying the given payload. It gets a unique ID so that it cannot be confused
+// with an event from the API.
+func newInjectedEvent(eventType InjectedEventType, payload any) (proton.Event, error) {
+	event := proton.Event{EventID: "injected-" + uuid.NewString()}
+
+	var ok bool
+
+	switch eventType {
+	case InjectedMessageEvents:
+		event.Messages, ok = payload.([]proton.MessageEvent)
+
+	case InjectedLabelEvents:
+		event.Labels, ok = payload.([]proton.LabelEvent)
+
+	case InjectedAddressEvents:
+		event.Addresses, ok = payload.([]proton.AddressEvent)
+
+	case InjectedUserEvent:
+		var user proton.User
+		if user, ok = payload.(proton.User); ok {
+			event.User = &user
+		}
+
+	case InjectedUsedSpaceEvent:
+		var usedSpace int64
+		if usedSpace, ok = payload.(int64); ok {
+			event.UsedSpace = &usedSpace
+		}
+
+	case InjectedRefreshEvent:
+		event.Refresh, ok = payload.(proton.RefreshFlag)
+
+	default:
+		return proton.Event{}, fmt.Errorf("%w: unknown type %v", ErrInvalidInjectedEvent, eventType)
+	}
+
+	if !ok {
+		return proton.Event{}, fmt.Errorf("%w: unexpected %T payload for %v event", ErrInvalidInjectedEvent, payload, eventType)
+	}
+
+	return event, nil
+}
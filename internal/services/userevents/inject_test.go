@@ -0,0 +1,135 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package userevents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events/mocks"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/orderedtasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_InjectEvent(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	messageHandler := NewMockMessageEventHandler(mockCtrl)
+	labelHandler := NewMockLabelEventHandler(mockCtrl)
+	addressHandler := NewMockAddressEventHandler(mockCtrl)
+
+	messageEvents := []proton.MessageEvent{{EventItem: proton.EventItem{ID: "message", Action: proton.EventCreate}}}
+	labelEvents := []proton.LabelEvent{{EventItem: proton.EventItem{ID: "label", Action: proton.EventUpdate}}}
+	addressEvents := []proton.AddressEvent{{EventItem: proton.EventItem{ID: "address", Action: proton.EventDelete}}}
+
+	// Each injected event only reaches the handler of its type, once.
+	messageCall := messageHandler.EXPECT().HandleMessageEvents(gomock.Any(), gomock.Eq(messageEvents)).Times(1).Return(nil)
+	labelCall := labelHandler.EXPECT().HandleLabelEvents(gomock.Any(), gomock.Eq(labelEvents)).After(messageCall).Times(1).Return(nil)
+	addressHandler.EXPECT().HandleAddressEvents(gomock.Any(), gomock.Eq(addressEvents)).After(labelCall).Times(1).Return(nil)
+
+	service := startInjectTestService(t)
+
+	service.Subscribe(NewCallbackSubscriber("test", EventHandler{
+		MessageHandler: messageHandler,
+		LabelHandler:   labelHandler,
+		AddressHandler: addressHandler,
+	}))
+
+	require.NoError(t, service.InjectEvent(context.Background(), InjectedMessageEvents, messageEvents))
+	require.NoError(t, service.InjectEvent(context.Background(), InjectedLabelEvents, labelEvents))
+	require.NoError(t, service.InjectEvent(context.Background(), InjectedAddressEvents, addressEvents))
+
+	// Injected events are not replayed to the subscribers which subscribe later.
+	require.Empty(t, service.recentEvents)
+}
+
+func TestService_InjectEvent_SubscriberError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	messageHandler := NewMockMessageEventHandler(mockCtrl)
+	messageHandler.EXPECT().HandleMessageEvents(gomock.Any(), gomock.Any()).Times(1).Return(context.DeadlineExceeded)
+
+	service := startInjectTestService(t)
+	service.Subscribe(NewCallbackSubscriber("test", EventHandler{MessageHandler: messageHandler}))
+
+	err := service.InjectEvent(context.Background(), InjectedMessageEvents, []proton.MessageEvent{{}})
+
+	var publishErr *eventPublishError
+	require.ErrorAs(t, err, &publishErr)
+	require.ErrorIs(t, publishErr.error, context.DeadlineExceeded)
+}
+
+func TestService_InjectEvent_InvalidPayload(t *testing.T) {
+	service := startInjectTestService(t)
+
+	err := service.InjectEvent(context.Background(), InjectedLabelEvents, []proton.MessageEvent{})
+	require.ErrorIs(t, err, ErrInvalidInjectedEvent)
+
+	err = service.InjectEvent(context.Background(), InjectedEventType(-1), nil)
+	require.ErrorIs(t, err, ErrInvalidInjectedEvent)
+}
+
+func TestNewInjectedEvent(t *testing.T) {
+	user, err := newInjectedEvent(InjectedUserEvent, proton.User{ID: "user"})
+	require.NoError(t, err)
+	require.Equal(t, "user", user.User.ID)
+
+	usedSpace, err := newInjectedEvent(InjectedUsedSpaceEvent, int64(42))
+	require.NoError(t, err)
+	require.Equal(t, int64(42), *usedSpace.UsedSpace)
+
+	refresh, err := newInjectedEvent(InjectedRefreshEvent, proton.RefreshMail)
+	require.NoError(t, err)
+	require.Equal(t, proton.RefreshMail, refresh.Refresh)
+
+	// Injected events cannot be confused with each other, nor with API events.
+	require.NotEqual(t, user.EventID, usedSpace.EventID)
+	require.Contains(t, user.EventID, "injected-")
+}
+
+// startInjectTestService starts a paused event service, which only publishes injected events.
+func startInjectTestService(t *testing.T) *Service {
+	group := orderedtasks.NewOrderedCancelGroup(async.NoopPanicHandler{})
+
+	service := NewService(
+		"foo",
+		&NullEventSource{},
+		NewInMemoryEventIDStore(),
+		mocks.NewMockEventPublisher(gomock.NewController(t)),
+		time.Millisecond,
+		time.Millisecond,
+		time.Second,
+		async.NoopPanicHandler{},
+		events.NewNullSubscription(),
+	)
+
+	_, err := service.Start(context.Background(), group)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		group.CancelAndWait()
+		service.Close()
+	})
+
+	return service
+}
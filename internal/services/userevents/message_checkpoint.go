@@ -0,0 +1,68 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package userevents
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtonMail/go-proton-api"
+)
+
+// MessageCheckpoint records how many of the message events of an event were handled by a PartialMessageEventHandler.
+// When the same event is handled again, e.g. because its publish timed out and the event loop retried it, handling
+// resumes after the message events which were already handled instead of restarting from the first one.
+type MessageCheckpoint struct {
+	eventID string
+	handled int
+	lock    sync.Mutex
+}
+
+// handle hands the message events of the given event over to the handler, skipping those already handled.
+func (c *MessageCheckpoint) handle(
+	ctx context.Context,
+	handler PartialMessageEventHandler,
+	eventID string,
+	events []proton.MessageEvent,
+) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.eventID != eventID {
+		c.eventID = eventID
+		c.handled = 0
+	}
+
+	if c.handled >= len(events) {
+		return nil
+	}
+
+	handled, err := handler.HandlePartial(ctx, events[c.handled:])
+
+	c.handled += handled
+
+	return err
+}
+
+// Handled returns the ID of the event being handled and how many of its message events were handled.
+func (c *MessageCheckpoint) Handled() (string, int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.eventID, c.handled
+}
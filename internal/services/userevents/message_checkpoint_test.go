@@ -0,0 +1,102 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package userevents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/stretchr/testify/require"
+)
+
+// partialMessageHandler records the message events it handles and fails, once, on the message with the given ID.
+type partialMessageHandler struct {
+	failOn  string
+	handled []string
+}
+
+func (h *partialMessageHandler) HandleMessageEvents(ctx context.Context, events []proton.MessageEvent) error {
+	_, err := h.HandlePartial(ctx, events)
+
+	return err
+}
+
+func (h *partialMessageHandler) HandlePartial(_ context.Context, events []proton.MessageEvent) (int, error) {
+	for i, event := range events {
+		if event.ID == h.failOn {
+			h.failOn = ""
+			return i, context.DeadlineExceeded
+		}
+
+		h.handled = append(h.handled, event.ID)
+	}
+
+	return len(events), nil
+}
+
+func newMessageEvents(eventID string, messageIDs ...string) proton.Event {
+	event := proton.Event{EventID: eventID}
+
+	for _, messageID := range messageIDs {
+		event.Messages = append(event.Messages, proton.MessageEvent{EventItem: proton.EventItem{ID: messageID}})
+	}
+
+	return event
+}
+
+func TestEventHandler_MessageCheckpointResumes(t *testing.T) {
+	handler := &partialMessageHandler{failOn: "m3"}
+	checkpoint := &MessageCheckpoint{}
+
+	eventHandler := EventHandler{MessageHandler: handler, MessageCheckpoint: checkpoint}
+	event := newMessageEvents("event1", "m1", "m2", "m3", "m4")
+
+	// The handler times out partway through the batch.
+	require.ErrorIs(t, eventHandler.OnEvent(context.Background(), event), context.DeadlineExceeded)
+	require.Equal(t, []string{"m1", "m2"}, handler.handled)
+
+	eventID, handled := checkpoint.Handled()
+	require.Equal(t, "event1", eventID)
+	require.Equal(t, 2, handled)
+
+	// Handling the event again resumes from the event which failed.
+	require.NoError(t, eventHandler.OnEvent(context.Background(), event))
+	require.Equal(t, []string{"m1", "m2", "m3", "m4"}, handler.handled)
+
+	// Once completed, the event is not handled again.
+	require.NoError(t, eventHandler.OnEvent(context.Background(), event))
+	require.Equal(t, []string{"m1", "m2", "m3", "m4"}, handler.handled)
+
+	// Another event starts from its first message event.
+	require.NoError(t, eventHandler.OnEvent(context.Background(), newMessageEvents("event2", "m1", "m5")))
+	require.Equal(t, []string{"m1", "m2", "m3", "m4", "m1", "m5"}, handler.handled)
+}
+
+func TestEventHandler_NoMessageCheckpointRestarts(t *testing.T) {
+	handler := &partialMessageHandler{failOn: "m3"}
+
+	eventHandler := EventHandler{MessageHandler: handler}
+	event := newMessageEvents("event1", "m1", "m2", "m3", "m4")
+
+	require.ErrorIs(t, eventHandler.OnEvent(context.Background(), event), context.DeadlineExceeded)
+	require.NoError(t, eventHandler.OnEvent(context.Background(), event))
+
+	// Without a checkpoint, the whole batch is handled again.
+	require.Equal(t, []string{"m1", "m2", "m1", "m2", "m3", "m4"}, handler.handled)
+}
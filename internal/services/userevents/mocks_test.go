@@ -36,15 +36,15 @@ func (m *MockEventSubscriber) EXPECT() *MockEventSubscriberMockRecorder {
 }
 
 // cancel mocks base method.
-func (m *MockEventSubscriber) cancel() {
+func (m *MockEventSubscriber) cancel(arg0 context.Context) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "cancel")
+	m.ctrl.Call(m, "cancel", arg0)
 }
 
 // cancel indicates an expected call of cancel.
-func (mr *MockEventSubscriberMockRecorder) cancel() *gomock.Call {
+func (mr *MockEventSubscriberMockRecorder) cancel(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cancel", reflect.TypeOf((*MockEventSubscriber)(nil).cancel))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "cancel", reflect.TypeOf((*MockEventSubscriber)(nil).cancel), arg0)
 }
 
 // close mocks base method.
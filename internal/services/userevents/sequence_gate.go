@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package userevents
+
+import (
+	"context"
+	"sync"
+)
+
+// sequenceGate lets the publishes to a subscriber hand their events over in the order in which they were published,
+// even if they overlap. Each publish takes a ticket when it computes its deliveries, and waits for the deliveries of
+// the previous tickets to be done before handing its events over.
+type sequenceGate struct {
+	lock sync.Mutex
+
+	// next is the ticket handed out to the next publish, serving the ticket of the publish allowed to deliver.
+	next    uint64
+	serving uint64
+
+	// done holds the tickets released before their turn, e.g. because their publish was abandoned.
+	done map[uint64]struct{}
+
+	// waiter is closed when the ticket it is keyed by may deliver.
+	waiters map[uint64]chan struct{}
+}
+
+func newSequenceGate() *sequenceGate {
+	return &sequenceGate{
+		done:    make(map[uint64]struct{}),
+		waiters: make(map[uint64]chan struct{}),
+	}
+}
+
+// take returns the ticket of a new publish. Tickets must be taken in publish order.
+func (g *sequenceGate) take() uint64 {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	ticket := g.next
+	g.next++
+
+	return ticket
+}
+
+// wait blocks until all the tickets before this one are released, or the context is done.
+func (g *sequenceGate) wait(ctx context.Context, ticket uint64) error {
+	g.lock.Lock()
+
+	if ticket == g.serving {
+		g.lock.Unlock()
+		return nil
+	}
+
+	ch := make(chan struct{})
+	g.waiters[ticket] = ch
+
+	g.lock.Unlock()
+
+	select {
+	case <-ch:
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release marks the delivery of the ticket as done, letting the following ticket deliver. Releasing a ticket more than
+// once has no effect.
+func (g *sequenceGate) release(ticket uint64) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	delete(g.waiters, ticket)
+
+	if ticket < g.serving {
+		return
+	}
+
+	g.done[ticket] = struct{}{}
+
+	for {
+		if _, ok := g.done[g.serving]; !ok {
+			break
+		}
+
+		delete(g.done, g.serving)
+		g.serving++
+	}
+
+	if ch, ok := g.waiters[g.serving]; ok {
+		close(ch)
+		delete(g.waiters, g.serving)
+	}
+}
@@ -38,8 +38,16 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/pkg/cpc"
 	"github.com/bradenaw/juniper/xmaps"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 )
 
+// recentEventsMaxSize is the number of recently published events remembered to replay the message events missed by
+// resubscribing subscribers.
+const recentEventsMaxSize = 64
+
+// defaultPollNowWindow is the minimum time between two immediate polls triggered with PollNow.
+const defaultPollNowWindow = 5 * time.Second
+
 // Service polls from the given event source and ensures that all the respective subscribers get notified
 // before proceeding to the next event. The events are published in the following order:
 // * Refresh
@@ -63,6 +71,10 @@ type Service struct {
 
 	subscriberList eventSubscriberList
 
+	// recentEvents are the last published events, oldest first, with only their message events. They are only accessed
+	// by the event loop.
+	recentEvents []proton.Event
+
 	pendingSubscriptionsLock sync.Mutex
 	pendingSubscriptions     []pendingSubscription
 
@@ -70,6 +82,17 @@ type Service struct {
 	eventPollWaitersLock sync.Mutex
 	eventSubscription    events.Subscription
 	eventWatcher         *watcher.Watcher[events.Event]
+
+	// drainCtx bounds the draining of unsubscribed subscribers, should they never be closed. It is cancelled on Close.
+	drainCtx   context.Context
+	stopDrains context.CancelFunc
+
+	// pollNowCh wakes the event loop up for an immediate poll; the polls requested within pollNowWindow of the last one
+	// are ignored.
+	pollNowCh     chan struct{}
+	pollNowWindow time.Duration
+	lastPollNow   time.Time
+	pollNowLock   sync.Mutex
 }
 
 func NewService(
@@ -83,15 +106,19 @@ func NewService(
 	panicHandler async.PanicHandler,
 	eventSubscription events.Subscription,
 ) *Service {
-	return &Service{
-		cpc:          cpc.NewCPC(),
-		userID:       userID,
-		eventSource:  eventSource,
-		eventIDStore: store,
-		log: logrus.WithFields(logrus.Fields{
-			"service": "user-events",
-			"user":    userID,
-		}),
+	log := logrus.WithFields(logrus.Fields{
+		"service": "user-events",
+		"user":    userID,
+	})
+
+	drainCtx, stopDrains := context.WithCancel(context.Background())
+
+	s := &Service{
+		cpc:               cpc.NewCPC(),
+		userID:            userID,
+		eventSource:       eventSource,
+		eventIDStore:      store,
+		log:               log,
 		eventPublisher:    eventPublisher,
 		timer:             proton.NewTicker(pollPeriod, jitter, panicHandler),
 		paused:            1,
@@ -99,7 +126,21 @@ func NewService(
 		panicHandler:      panicHandler,
 		eventSubscription: eventSubscription,
 		eventWatcher:      eventSubscription.Add(events.ConnStatusDown{}, events.ConnStatusUp{}),
+		drainCtx:          drainCtx,
+		stopDrains:        stopDrains,
+		pollNowCh:         make(chan struct{}, 1),
+		pollNowWindow:     defaultPollNowWindow,
 	}
+
+	s.subscriberList.SetLogger(log)
+
+	return s
+}
+
+// SetPublishWorkers sets the number of subscribers the events are handed over to concurrently. A non-positive number
+// selects the default, half the number of CPUs, but at least 1.
+func (s *Service) SetPublishWorkers(workers int) {
+	s.subscriberList.SetPublishWorkers(workers)
 }
 
 // Subscribe adds new subscribers to the service.
@@ -111,10 +152,26 @@ func (s *Service) Subscribe(subscription EventSubscriber) {
 	s.pendingSubscriptions = append(s.pendingSubscriptions, pendingSubscription{op: pendingOpAdd, sub: subscription})
 }
 
+// SubscribeSince is like Subscribe, but the message events published after the event with the given ID, which the
+// subscriber missed e.g. while reconnecting, are replayed to it first. Only the most recent events are remembered;
+// if the given event is older than all of them, all the remembered message events are replayed. An empty event ID
+// replays nothing.
+// This method can safely be called during event handling.
+func (s *Service) SubscribeSince(subscription EventSubscriber, sinceEventID string) {
+	s.pendingSubscriptionsLock.Lock()
+	defer s.pendingSubscriptionsLock.Unlock()
+
+	s.pendingSubscriptions = append(s.pendingSubscriptions, pendingSubscription{
+		op:    pendingOpAdd,
+		sub:   subscription,
+		since: sinceEventID,
+	})
+}
+
 // Unsubscribe removes subscribers from the service.
 // This method can safely be called during event handling.
 func (s *Service) Unsubscribe(subscription EventSubscriber) {
-	subscription.cancel()
+	subscription.cancel(s.drainCtx)
 
 	s.pendingSubscriptionsLock.Lock()
 	defer s.pendingSubscriptionsLock.Unlock()
@@ -149,6 +206,28 @@ func (s *Service) Resume() {
 	atomic.StoreUint32(&s.paused, 0)
 }
 
+// PollNow makes the service poll for new events right away instead of waiting for the next poll period, e.g. because a
+// client is waiting for new messages. The requests made shortly after the previous one are ignored, so as not to
+// hammer the API; PollNow returns whether the request was accepted. Nothing is polled while the service is paused.
+func (s *Service) PollNow() bool {
+	s.pollNowLock.Lock()
+	defer s.pollNowLock.Unlock()
+
+	if now := time.Now(); now.Sub(s.lastPollNow) >= s.pollNowWindow {
+		s.lastPollNow = now
+	} else {
+		return false
+	}
+
+	select {
+	case s.pollNowCh <- struct{}{}:
+	default:
+		// A poll is already pending.
+	}
+
+	return true
+}
+
 // IsPaused return true if the service is paused.
 func (s *Service) IsPaused() bool {
 	return atomic.LoadUint32(&s.paused) == 1
@@ -161,6 +240,26 @@ func (s *Service) RewindEventID(ctx context.Context, id string) error {
 	return err
 }
 
+// SubscriberSnapshot returns, for each subscriber which records its deliveries, the last event it handled
+// successfully. See ChanneledSubscriber.SetRecordLastDelivered.
+func (s *Service) SubscriberSnapshot(ctx context.Context) (map[string]proton.Event, error) {
+	return cpc.SendTyped[map[string]proton.Event](ctx, s.cpc, &subscriberSnapshotReq{})
+}
+
+// InjectEvent publishes a synthetic event of the given type to the subscribers, as if it had been polled from the API,
+// and returns once they handled it. It lets tests check how subscribers react to events without a live server.
+// See InjectedEventType for the payload each type expects. The service must have been started.
+func (s *Service) InjectEvent(ctx context.Context, eventType InjectedEventType, payload any) error {
+	event, err := newInjectedEvent(eventType, payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.cpc.Send(ctx, &injectEventReq{event: event})
+
+	return err
+}
+
 // Start the event service and return the last EventID that was processed.
 func (s *Service) Start(ctx context.Context, group *orderedtasks.OrderedCancelGroup) (string, error) {
 	lastEventID, err := s.eventIDStore.Load(ctx)
@@ -211,22 +310,38 @@ func (s *Service) run(ctx context.Context, lastEventID string) {
 				continue
 			}
 
+		case <-s.pollNowCh:
+			if s.IsPaused() {
+				continue
+			}
+
+			s.log.Debug("Polling events on request")
+
 		case r, ok := <-s.cpc.ReceiveCh():
 			if !ok {
 				return
 			}
 
-			rewind, ok := r.Value().(*rewindEventIDReq)
-			if !ok {
-				s.log.Errorf("Received unknown request")
-				continue
-			}
+			switch req := r.Value().(type) {
+			case *rewindEventIDReq:
+				err := s.rewindEventLoop(ctx, req.eventID)
+				r.Reply(ctx, nil, err)
+
+				if err == nil {
+					lastEventID = req.eventID
+				}
 
-			err := s.rewindEventLoop(ctx, rewind.eventID)
-			r.Reply(ctx, nil, err)
+			case *subscriberSnapshotReq:
+				r.Reply(ctx, s.subscriberList.Snapshot(), nil)
 
-			if err == nil {
-				lastEventID = rewind.eventID
+			case *injectEventReq:
+				// Subscribe and Unsubscribe calls made before the injection apply to it, as for a polled event.
+				s.applyPendingSubscriptions(ctx)
+				// Injected events are not remembered, so they are never replayed to subscribers as if they were real.
+				r.Reply(ctx, nil, s.publishEvent(ctx, lastEventID, req.event))
+
+			default:
+				s.log.Errorf("Received unknown request")
 			}
 
 			continue
@@ -245,21 +360,7 @@ func (s *Service) run(ctx context.Context, lastEventID string) {
 			}
 		}
 
-		// Apply any pending subscription changes.
-		func() {
-			s.pendingSubscriptionsLock.Lock()
-			defer s.pendingSubscriptionsLock.Unlock()
-
-			for _, p := range s.pendingSubscriptions {
-				if p.op == pendingOpAdd {
-					s.addSubscription(p.sub)
-				} else {
-					s.removeSubscription(p.sub)
-				}
-			}
-
-			s.pendingSubscriptions = nil
-		}()
+		s.applyPendingSubscriptions(ctx)
 
 		newEvents, err := network.RetryWithClient(ctx, client, func(ctx context.Context, eventSource EventSource) ([]proton.Event, error) {
 			newEvents, _, err := eventSource.GetEvent(ctx, lastEventID)
@@ -312,8 +413,30 @@ func (s *Service) run(ctx context.Context, lastEventID string) {
 	}
 }
 
+// applyPendingSubscriptions applies the subscription changes made since the last call.
+func (s *Service) applyPendingSubscriptions(ctx context.Context) {
+	s.pendingSubscriptionsLock.Lock()
+	defer s.pendingSubscriptionsLock.Unlock()
+
+	for _, p := range s.pendingSubscriptions {
+		if p.op == pendingOpAdd {
+			if p.since != "" {
+				s.replayMessageEvents(ctx, p.sub, p.since)
+			}
+
+			s.addSubscription(p.sub)
+		} else {
+			s.removeSubscription(p.sub)
+		}
+	}
+
+	s.pendingSubscriptions = nil
+}
+
 // Close should be called after the service has been cancelled to clean up any remaining pending operations.
 func (s *Service) Close() {
+	defer s.stopDrains()
+
 	if s.eventSubscription != nil {
 		s.eventSubscription.Remove(s.eventWatcher)
 		s.eventSubscription = nil
@@ -324,6 +447,8 @@ func (s *Service) Close() {
 
 	processed := xmaps.Set[EventSubscriber]{}
 
+	drainCtx := s.drainCtx
+
 	// Cleanup pending removes.
 	for _, s := range s.pendingSubscriptions {
 		if !processed.Contains(s.sub) {
@@ -332,7 +457,7 @@ func (s *Service) Close() {
 			if s.op == pendingOpRemove {
 				s.sub.close()
 			} else {
-				s.sub.cancel()
+				s.sub.cancel(drainCtx)
 				s.sub.close()
 			}
 		}
@@ -352,7 +477,19 @@ func (s *Service) closePollWaiters() {
 	s.eventPollWaiters = nil
 }
 
+// handleEvent publishes the given event polled from the API, and remembers its message events to replay them.
 func (s *Service) handleEvent(ctx context.Context, lastEventID string, event proton.Event) error {
+	if err := s.publishEvent(ctx, lastEventID, event); err != nil {
+		return err
+	}
+
+	s.rememberEvent(event)
+
+	return nil
+}
+
+// publishEvent hands the given event over to the subscribers.
+func (s *Service) publishEvent(ctx context.Context, lastEventID string, event proton.Event) error {
 	s.log.WithFields(logrus.Fields{
 		"old": lastEventID,
 		"new": event,
@@ -365,6 +502,42 @@ func (s *Service) handleEvent(ctx context.Context, lastEventID string, event pro
 	return s.subscriberList.PublishParallel(ctx, event, s.panicHandler)
 }
 
+// rememberEvent keeps the message events of the given event, if it was not already, dropping the oldest event if too
+// many are remembered.
+func (s *Service) rememberEvent(event proton.Event) {
+	if slices.IndexFunc(s.recentEvents, func(e proton.Event) bool { return e.EventID == event.EventID }) >= 0 {
+		return
+	}
+
+	s.recentEvents = append(s.recentEvents, proton.Event{EventID: event.EventID, Messages: event.Messages})
+
+	if overflow := len(s.recentEvents) - recentEventsMaxSize; overflow > 0 {
+		s.recentEvents = slices.Delete(s.recentEvents, 0, overflow)
+	}
+}
+
+// replayMessageEvents hands the remembered message events published after the given event over to the subscriber.
+func (s *Service) replayMessageEvents(ctx context.Context, subscription EventSubscriber, sinceEventID string) {
+	missed := s.recentEvents
+
+	if index := slices.IndexFunc(missed, func(e proton.Event) bool { return e.EventID == sinceEventID }); index >= 0 {
+		missed = missed[index+1:]
+	} else if len(missed) > 0 {
+		s.log.WithField("eventID", sinceEventID).Warn("Event is not recent, replaying all recent message events")
+	}
+
+	for _, event := range missed {
+		if len(event.Messages) == 0 {
+			continue
+		}
+
+		if err := subscription.handle(ctx, event); err != nil {
+			s.log.WithError(err).WithField("subscriber", subscription.name()).Error("Failed to replay message events")
+			return
+		}
+	}
+}
+
 func unpackPublisherError(err error) (string, error) {
 	var publishErr *eventPublishError
 
@@ -454,10 +627,17 @@ const (
 )
 
 type pendingSubscription struct {
-	op  pendingOp
-	sub EventSubscriber
+	op    pendingOp
+	sub   EventSubscriber
+	since string // The ID of the last event the subscriber handled, if any.
 }
 
 type rewindEventIDReq struct {
 	eventID string
 }
+
+type subscriberSnapshotReq struct{}
+
+type injectEventReq struct {
+	event proton.Event
+}
@@ -205,4 +205,4 @@ func (n noOpSubscriber[T]) handle(_ context.Context, _ T) error { //nolint:unuse
 func (n noOpSubscriber[T]) close() {} //
 
 //nolint:unused
-func (n noOpSubscriber[T]) cancel() {}
+func (n noOpSubscriber[T]) cancel(_ context.Context) {}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -396,6 +397,94 @@ func TestService_WaitOnEventPublishAfterPause(t *testing.T) {
 	group.Wait()
 }
 
+func TestService_PollNow(t *testing.T) {
+	group := orderedtasks.NewOrderedCancelGroup(async.NoopPanicHandler{})
+	defer group.Wait()
+	defer group.Cancel()
+
+	mockCtrl := gomock.NewController(t)
+	eventPublisher := mocks2.NewMockEventPublisher(mockCtrl)
+	eventIDStore := mocks.NewMockEventIDStore(mockCtrl)
+	eventSource := mocks.NewMockEventSource(mockCtrl)
+
+	eventID := "EVENT01"
+
+	var polls atomic.Int32
+
+	eventIDStore.EXPECT().Load(gomock.Any()).Times(1).Return(eventID, nil)
+	eventSource.EXPECT().GetEvent(gomock.Any(), gomock.Eq(eventID)).AnyTimes().DoAndReturn(
+		func(context.Context, string) ([]proton.Event, bool, error) {
+			polls.Add(1)
+
+			return []proton.Event{{EventID: eventID}}, false, nil
+		},
+	)
+
+	// The poll period is too long for any event to be polled during the test otherwise.
+	service := NewService(
+		"foo",
+		eventSource,
+		eventIDStore,
+		eventPublisher,
+		time.Hour,
+		time.Millisecond,
+		time.Second,
+		async.NoopPanicHandler{},
+		events.NewNullSubscription(),
+	)
+	service.pollNowWindow = 200 * time.Millisecond
+
+	_, err := service.Start(context.Background(), group)
+	require.NoError(t, err)
+
+	service.Resume()
+
+	// Polling now fetches the events right away.
+	require.True(t, service.PollNow())
+	require.Eventually(t, func() bool { return polls.Load() == 1 }, time.Second, time.Millisecond)
+
+	// Further requests within the window are debounced.
+	require.False(t, service.PollNow())
+	require.False(t, service.PollNow())
+	require.Never(t, func() bool { return polls.Load() > 1 }, 100*time.Millisecond, time.Millisecond)
+
+	// Once the window is over, requests are accepted again.
+	require.Eventually(t, service.PollNow, time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return polls.Load() == 2 }, time.Second, time.Millisecond)
+}
+
+func TestService_PollNowWhilePaused(t *testing.T) {
+	group := orderedtasks.NewOrderedCancelGroup(async.NoopPanicHandler{})
+	defer group.Wait()
+	defer group.Cancel()
+
+	mockCtrl := gomock.NewController(t)
+	eventPublisher := mocks2.NewMockEventPublisher(mockCtrl)
+	eventIDStore := mocks.NewMockEventIDStore(mockCtrl)
+	eventSource := mocks.NewMockEventSource(mockCtrl)
+
+	// No event is polled while the service is paused.
+	eventIDStore.EXPECT().Load(gomock.Any()).Times(1).Return("EVENT01", nil)
+
+	service := NewService(
+		"foo",
+		eventSource,
+		eventIDStore,
+		eventPublisher,
+		time.Hour,
+		time.Millisecond,
+		time.Second,
+		async.NoopPanicHandler{},
+		events.NewNullSubscription(),
+	)
+
+	_, err := service.Start(context.Background(), group)
+	require.NoError(t, err)
+
+	require.True(t, service.PollNow())
+	time.Sleep(50 * time.Millisecond)
+}
+
 func TestService_EventRewind(t *testing.T) {
 	group := orderedtasks.NewOrderedCancelGroup(async.NoopPanicHandler{})
 	mockCtrl := gomock.NewController(t)
@@ -455,6 +544,85 @@ func TestService_EventRewind(t *testing.T) {
 	group.Wait()
 }
 
+func TestService_SubscribeSinceReplaysMissedMessageEvents(t *testing.T) {
+	group := orderedtasks.NewOrderedCancelGroup(async.NoopPanicHandler{})
+	mockCtrl := gomock.NewController(t)
+	eventPublisher := mocks2.NewMockEventPublisher(mockCtrl)
+	eventIDStore := mocks.NewMockEventIDStore(mockCtrl)
+	eventSource := mocks.NewMockEventSource(mockCtrl)
+	subscriber := NewMockMessageEventHandler(mockCtrl)
+
+	newMessageEvents := func(id string) []proton.MessageEvent {
+		return []proton.MessageEvent{{EventItem: proton.EventItem{ID: id}}}
+	}
+
+	firstEventID := "EVENT01"
+	missedEvents := []proton.Event{
+		{EventID: "EVENT02", Messages: newMessageEvents("seen")},
+		{EventID: "EVENT03", Messages: newMessageEvents("missed")},
+	}
+	liveEvents := []proton.Event{
+		{EventID: "EVENT04", Messages: newMessageEvents("live")},
+	}
+
+	service := NewService(
+		"foo",
+		eventSource,
+		eventIDStore,
+		eventPublisher,
+		time.Millisecond,
+		time.Millisecond,
+		time.Second,
+		async.NoopPanicHandler{},
+		events.NewNullSubscription(),
+	)
+
+	// Event id store expectations.
+	eventIDStore.EXPECT().Load(gomock.Any()).Times(1).Return(firstEventID, nil)
+	eventIDStore.EXPECT().Store(gomock.Any(), gomock.Eq("EVENT03")).Times(1).DoAndReturn(func(_ context.Context, _ string) error {
+		// The subscriber reconnects having only handled the first of the missed events.
+		service.SubscribeSince(NewCallbackSubscriber("foo", EventHandler{MessageHandler: subscriber}), "EVENT02")
+		return nil
+	})
+	eventIDStore.EXPECT().Store(gomock.Any(), gomock.Eq("EVENT04")).Times(1).DoAndReturn(func(_ context.Context, _ string) error {
+		// Force exit, we have finished executing what we expected.
+		group.Cancel()
+		return nil
+	})
+
+	// Event Source expectations.
+	eventSource.EXPECT().GetEvent(gomock.Any(), gomock.Eq(firstEventID)).Times(1).Return(missedEvents, false, nil)
+	eventSource.EXPECT().GetEvent(gomock.Any(), gomock.Eq("EVENT03")).Times(1).Return(liveEvents, false, nil)
+
+	// Subscriber expectations.
+	{
+		replayed := subscriber.EXPECT().HandleMessageEvents(gomock.Any(), gomock.Eq(newMessageEvents("missed"))).Times(1).Return(nil)
+		subscriber.EXPECT().HandleMessageEvents(gomock.Any(), gomock.Eq(newMessageEvents("live"))).After(replayed).Times(1).Return(nil)
+	}
+
+	_, err := service.Start(context.Background(), group)
+	require.NoError(t, err)
+
+	service.Resume()
+	group.Wait()
+}
+
+func TestService_RecentEventsAreBounded(t *testing.T) {
+	service := &Service{}
+
+	for i := 0; i < 2*recentEventsMaxSize; i++ {
+		event := proton.Event{EventID: fmt.Sprintf("EVENT%03d", i)}
+
+		// Events republished after a failure are only remembered once.
+		service.rememberEvent(event)
+		service.rememberEvent(event)
+	}
+
+	require.Len(t, service.recentEvents, recentEventsMaxSize)
+	require.Equal(t, fmt.Sprintf("EVENT%03d", recentEventsMaxSize), service.recentEvents[0].EventID)
+	require.Equal(t, fmt.Sprintf("EVENT%03d", 2*recentEventsMaxSize-1), service.recentEvents[recentEventsMaxSize-1].EventID)
+}
+
 type CallbackSubscriber struct {
 	handler EventHandler
 	n       string
@@ -471,7 +639,7 @@ func (c CallbackSubscriber) handle(ctx context.Context, t proton.Event) error {
 	return c.handler.OnEvent(ctx, t)
 }
 
-func (c CallbackSubscriber) cancel() { //nolint: unused
+func (c CallbackSubscriber) cancel(_ context.Context) { //nolint: unused
 	// Nothing to do.
 }
 
@@ -21,12 +21,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/bradenaw/juniper/parallel"
 	"github.com/bradenaw/juniper/xslices"
+	"github.com/hashicorp/go-multierror"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/slices"
 )
 
@@ -36,6 +42,10 @@ func newSubscriber(name string) *EventChanneledSubscriber {
 	return newChanneledSubscriber[proton.Event](name)
 }
 
+func newBufferedSubscriber(name string, bufferSize int) *EventChanneledSubscriber {
+	return newBufferedChanneledSubscriber[proton.Event](name, bufferSize)
+}
+
 type EventSubscriber = subscriber[proton.Event]
 
 // Subscriber is the main entry point of interacting with user generated events.
@@ -46,32 +56,437 @@ type subscriber[T any] interface {
 	handle(context.Context, T) error
 	// cancel is behavior extension for channel based subscribers so that they can ensure that
 	// if a subscriber unsubscribes, it doesn't cause pending events on the channel to time-out as there is no one to handle
-	// them.
-	cancel()
+	// them. Cancelling the context stops this as well.
+	cancel(ctx context.Context)
 	// close release all associated resources
 	close()
 }
 
+// readinessReporter is an optional extension of subscriber. Subscribers which need some time before they are able to
+// handle events (e.g. during startup) can implement it to be skipped by Publish until they are ready. Subscribers which
+// do not implement it are always considered ready.
+type readinessReporter interface {
+	ready() bool
+}
+
+func isSubscriberReady[T any](subscriber subscriber[T]) bool {
+	if r, ok := subscriber.(readinessReporter); ok {
+		return r.ready()
+	}
+
+	return true
+}
+
+// timeoutReporter is an optional extension of subscriber. Subscribers which implement it get their own deadline for
+// handling each event, derived from the publish context, so that a slow subscriber does not use up the time of the
+// others. A non-positive timeout leaves the publish context as is.
+type timeoutReporter interface {
+	timeout() time.Duration
+}
+
+// handleWithTimeout hands the event over to the subscriber, within the subscriber's own timeout if it has one.
+func handleWithTimeout[T any](ctx context.Context, subscriber subscriber[T], event T) error {
+	if r, ok := subscriber.(timeoutReporter); ok {
+		if timeout := r.timeout(); timeout > 0 {
+			var cancel context.CancelFunc
+
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+	}
+
+	return subscriber.handle(ctx, event)
+}
+
+// RetryableError is implemented by the errors of subscribers which failed transiently, e.g. because a database was
+// momentarily locked. If retries are enabled, the subscribers which fail with such an error are handed the event again.
+type RetryableError interface {
+	error
+	Retryable() bool
+}
+
+func isRetryable(err error) bool {
+	var retryable RetryableError
+
+	return errors.As(err, &retryable) && retryable.Retryable()
+}
+
+// lastDeliveredReporter is an optional extension of subscriber. Subscribers which record the last event they handled
+// successfully, for diagnostics, implement it to be included in Snapshot.
+type lastDeliveredReporter[T any] interface {
+	// lastDelivered returns the last event handled successfully, and false if there is none.
+	lastDelivered() (T, bool)
+}
+
+// subscriberList is safe for concurrent use. Publishing only holds the read lock while it takes a snapshot of the
+// subscribers, so that subscribers can be added and removed, even by their own handlers, while events are delivered.
+// A subscriber removed during a publish may thus still be handed the event; subscribers must tolerate being closed
+// concurrently with handle.
 type subscriberList[T any] struct {
+	lock sync.RWMutex
+
+	// subscribers are kept sorted by decreasing priority, and in insertion order for equal priorities.
 	subscribers []subscriber[T]
+	priorities  map[subscriber[T]]int
+
+	// gates keep the events handed over to each subscriber in publish order when publishes overlap.
+	gates map[subscriber[T]]*sequenceGate
+
+	// notReadyBufferSize is the maximum number of events kept for a subscriber which is not ready. When it is 0, events
+	// published while a subscriber is not ready are never delivered to it.
+	notReadyBufferSize int
+
+	// maxRetries is the number of times a subscriber failing with a RetryableError is retried, waiting retryBackoff
+	// before the first retry and twice as long before each following one.
+	maxRetries   int
+	retryBackoff time.Duration
+
+	// publishWorkers is the number of subscribers PublishParallel hands events over to concurrently; if it is not
+	// positive, defaultPublishWorkers is used.
+	publishWorkers int
+
+	// log is where the handling of each event by each subscriber is logged; the standard logger is used if it is nil.
+	log *logrus.Entry
+
+	// pending is updated while taking the snapshot of a publish, under the read lock, so it has its own lock.
+	pending     map[subscriber[T]][]T
+	pendingLock sync.Mutex
+}
+
+// delivery is the list of events which should be handed over to a subscriber on publish.
+type delivery[T any] struct {
+	subscriber subscriber[T]
+	events     []T
+
+	// gate and ticket order this delivery after those of the previous publishes to the same subscriber.
+	gate   *sequenceGate
+	ticket uint64
+
+	// opts are the settings of the list when the delivery was taken, so that they are not read without the lock.
+	opts handleOptions
+}
+
+// handleOptions are the settings of a subscriber list with which subscribers are handed events.
+type handleOptions struct {
+	maxRetries   int
+	retryBackoff time.Duration
+	log          *logrus.Entry
+}
+
+// release lets the deliveries of the following publishes to the subscriber proceed.
+func (d delivery[T]) release() {
+	d.gate.release(d.ticket)
+}
+
+// releaseDeliveries releases all the deliveries of a publish, including those which were abandoned.
+func releaseDeliveries[T any](deliveries []delivery[T]) {
+	for _, delivery := range deliveries {
+		delivery.release()
+	}
 }
 
 type eventSubscriberList = subscriberList[proton.Event]
 
 func (s *subscriberList[T]) Add(subscriber subscriber[T]) {
-	if !slices.Contains(s.subscribers, subscriber) {
-		s.subscribers = append(s.subscribers, subscriber)
+	s.AddWithPriority(subscriber, 0)
+}
+
+// AddWithPriority adds a subscriber which receives events before the subscribers with a lower priority, and after
+// the ones with a higher priority. Subscribers with the same priority receive events in the order they were added.
+// Subscribers added with Add have priority 0. Priorities are only honoured by Publish and PublishAll.
+func (s *subscriberList[T]) AddWithPriority(sub subscriber[T], priority int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if slices.Contains(s.subscribers, sub) {
+		return
+	}
+
+	if s.priorities == nil {
+		s.priorities = make(map[subscriber[T]]int)
+	}
+
+	s.priorities[sub] = priority
+
+	if s.gates == nil {
+		s.gates = make(map[subscriber[T]]*sequenceGate)
 	}
+
+	s.gates[sub] = newSequenceGate()
+
+	index := slices.IndexFunc(s.subscribers, func(other subscriber[T]) bool {
+		return s.priorities[other] < priority
+	})
+	if index < 0 {
+		index = len(s.subscribers)
+	}
+
+	s.subscribers = slices.Insert(s.subscribers, index, sub)
 }
 
 func (s *subscriberList[T]) Remove(subscriber subscriber[T]) {
-	index := slices.Index(s.subscribers, subscriber)
-	if index < 0 {
+	if !s.remove(subscriber) {
 		return
 	}
 
-	s.subscribers[index].close()
+	// The following publishes no longer see the subscriber; those in progress may still hand it events, which it
+	// rejects once closed.
+	subscriber.close()
+}
+
+func (s *subscriberList[T]) remove(sub subscriber[T]) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	index := slices.Index(s.subscribers, sub)
+	if index < 0 {
+		return false
+	}
+
+	s.pendingLock.Lock()
+	delete(s.pending, sub)
+	s.pendingLock.Unlock()
+
+	delete(s.priorities, sub)
+	delete(s.gates, sub)
+
 	s.subscribers = xslices.Remove(s.subscribers, index, 1)
+
+	return true
+}
+
+// Len returns the number of registered subscribers.
+func (s *subscriberList[T]) Len() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return len(s.subscribers)
+}
+
+// Names returns the names of the registered subscribers, in the order in which they receive events.
+// The returned slice is a copy which is not affected by later changes to the list.
+func (s *subscriberList[T]) Names() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return xslices.Map(s.subscribers, func(subscriber subscriber[T]) string {
+		return subscriber.name()
+	})
+}
+
+// SetNotReadyBufferSize configures how many events are kept for subscribers which are not ready. These events are
+// delivered, oldest first, on the first publish after the subscriber became ready. If more events are published while
+// a subscriber is not ready, the oldest ones are dropped.
+func (s *subscriberList[T]) SetNotReadyBufferSize(size int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.pendingLock.Lock()
+	defer s.pendingLock.Unlock()
+
+	s.notReadyBufferSize = size
+
+	if size <= 0 {
+		s.pending = nil
+		return
+	}
+
+	for subscriber, events := range s.pending {
+		if len(events) > size {
+			s.pending[subscriber] = events[len(events)-size:]
+		}
+	}
+}
+
+// SetRetries sets how many times a subscriber which fails with a RetryableError is handed the event again, waiting
+// initialBackoff before the first retry and doubling the wait before each following one. Other errors are never
+// retried. Retries are disabled by default.
+func (s *subscriberList[T]) SetRetries(maxRetries int, initialBackoff time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.maxRetries = maxRetries
+	s.retryBackoff = initialBackoff
+}
+
+// SetPublishWorkers sets the number of subscribers PublishParallel hands events over to concurrently. A non-positive
+// number selects the default, half the number of CPUs.
+func (s *subscriberList[T]) SetPublishWorkers(workers int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.publishWorkers = workers
+}
+
+// getPublishWorkers returns the number of workers used by PublishParallel, which is at least 1.
+func (s *subscriberList[T]) getPublishWorkers() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.publishWorkers > 0 {
+		return s.publishWorkers
+	}
+
+	return defaultPublishWorkers()
+}
+
+// defaultPublishWorkers returns the default number of workers used by PublishParallel: half the number of CPUs, but
+// at least 1.
+func defaultPublishWorkers() int {
+	if workers := runtime.NumCPU() / 2; workers > 1 {
+		return workers
+	}
+
+	return 1
+}
+
+// SetLogger sets the logger to which the handling of each event by each subscriber is logged.
+func (s *subscriberList[T]) SetLogger(log *logrus.Entry) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.log = log
+}
+
+// handle hands the event over to the subscriber, and logs how long it took. Successes are logged at debug level,
+// failures at warning level.
+func handle[T any](ctx context.Context, opts handleOptions, subscriber subscriber[T], event T) error {
+	start := time.Now()
+
+	err := handleWithRetries(ctx, opts, subscriber, event)
+
+	log := opts.log
+	if log == nil {
+		log = logrus.NewEntry(logrus.StandardLogger())
+	}
+
+	log = log.WithFields(logrus.Fields{
+		"subscriber": subscriber.name(),
+		"eventType":  fmt.Sprintf("%T", event),
+		"duration":   time.Since(start),
+	})
+
+	if value := reflect.ValueOf(event); value.Kind() == reflect.Slice {
+		log = log.WithField("items", value.Len())
+	}
+
+	if errors.Is(err, ErrSubscriberCancelled) {
+		// The subscriber is going away and dropped the event, reporting it to its OnDropped callback. The publish does
+		// not fail, as it would then be retried and the event handed over again to the other subscribers.
+		log.Warn("Subscriber cancelled, event dropped")
+		return nil
+	}
+
+	if err != nil {
+		log.WithError(err).Warn("Subscriber failed to handle event")
+	} else {
+		log.Debug("Subscriber handled event")
+	}
+
+	return err
+}
+
+// handleWithRetries hands the event over to the subscriber, retrying as configured if it fails with a retryable error.
+func handleWithRetries[T any](ctx context.Context, opts handleOptions, subscriber subscriber[T], event T) error {
+	backoff := opts.retryBackoff
+
+	for retry := 1; ; retry++ {
+		err := handleWithTimeout(ctx, subscriber, event)
+		if err == nil || retry > opts.maxRetries || !isRetryable(err) {
+			return err
+		}
+
+		logrus.WithError(err).WithField("subscriber", subscriber.name()).WithField("retry", retry).Warn("Retrying event")
+
+		select {
+		case <-ctx.Done():
+			return err
+
+		case <-time.After(backoff):
+			backoff *= 2
+		}
+	}
+}
+
+// deliveries returns the events each ready subscriber should receive for this publish. Subscribers which are not ready
+// are skipped and, if configured, the event is buffered for them instead. The returned deliveries are a snapshot of the
+// subscribers, taken under the read lock, which is not held while the subscribers are handed the events; they must all
+// be released afterwards. They also hold the settings with which the events are handed over.
+func (s *subscriberList[T]) deliveries(event T) []delivery[T] {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	s.pendingLock.Lock()
+	defer s.pendingLock.Unlock()
+
+	deliveries := make([]delivery[T], 0, len(s.subscribers))
+
+	opts := handleOptions{
+		maxRetries:   s.maxRetries,
+		retryBackoff: s.retryBackoff,
+		log:          s.log,
+	}
+
+	for _, subscriber := range s.subscribers {
+		if !isSubscriberReady(subscriber) {
+			s.bufferEvent(subscriber, event)
+			continue
+		}
+
+		events := append(s.pending[subscriber], event)
+		delete(s.pending, subscriber)
+
+		gate := s.gates[subscriber]
+
+		deliveries = append(deliveries, delivery[T]{
+			subscriber: subscriber,
+			events:     events,
+			gate:       gate,
+			ticket:     gate.take(),
+			opts:       opts,
+		})
+	}
+
+	return deliveries
+}
+
+func (s *subscriberList[T]) bufferEvent(sub subscriber[T], event T) {
+	if s.notReadyBufferSize <= 0 {
+		return
+	}
+
+	if s.pending == nil {
+		s.pending = make(map[subscriber[T]][]T)
+	}
+
+	events := append(s.pending[sub], event)
+	if len(events) > s.notReadyBufferSize {
+		events = events[len(events)-s.notReadyBufferSize:]
+	}
+
+	s.pending[sub] = events
+}
+
+// Snapshot returns, for each subscriber which records its deliveries, the last event it handled successfully, keyed
+// by subscriber name. Subscribers which did not handle any event yet are omitted.
+func (s *subscriberList[T]) Snapshot() map[string]T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	snapshot := make(map[string]T)
+
+	for _, subscriber := range s.subscribers {
+		r, ok := subscriber.(lastDeliveredReporter[T])
+		if !ok {
+			continue
+		}
+
+		if event, ok := r.lastDelivered(); ok {
+			snapshot[subscriber.name()] = event
+		}
+	}
+
+	return snapshot
 }
 
 type publishError[T any] struct {
@@ -81,6 +496,33 @@ type publishError[T any] struct {
 
 var ErrPublishTimeoutExceeded = errors.New("event publish timed out")
 
+// ErrSubscriberCancelled is the reply to the events received by a subscriber after it was cancelled or closed, which
+// were not handled.
+var ErrSubscriberCancelled = errors.New("subscriber cancelled")
+
+// QueuedEventError is the reply of a buffered subscriber whose consumer failed to handle an event queued by a previous
+// publish. It is returned to the next publish to the subscriber, which is ordered after the failed one.
+type QueuedEventError[T any] struct {
+	Event T
+	Err   error
+}
+
+func (e *QueuedEventError[T]) Error() string {
+	return fmt.Sprintf("failed to handle queued event: %v", e.Err)
+}
+
+func (e *QueuedEventError[T]) Unwrap() error {
+	return e.Err
+}
+
+// Retryable is false: retrying would hand over the event of the next publish again, not the failed one.
+func (e *QueuedEventError[T]) Retryable() bool {
+	return false
+}
+
+// ErrSubscriberPanicked is the cause of the publish errors of subscribers which panicked while handling an event.
+var ErrSubscriberPanicked = errors.New("subscriber panicked")
+
 type eventPublishError = publishError[proton.Event]
 
 func (p publishError[T]) Error() string {
@@ -88,70 +530,170 @@ func (p publishError[T]) Error() string {
 }
 
 func (s *subscriberList[T]) Publish(ctx context.Context, event T) error {
-	for _, subscriber := range s.subscribers {
-		if err := subscriber.handle(ctx, event); err != nil {
-			return &publishError[T]{
-				subscriber: subscriber,
-				error:      err,
-			}
-		}
+	deliveries := s.deliveries(event)
+	defer releaseDeliveries(deliveries)
 
-		if err := ctx.Err(); err != nil {
-			return &publishError[T]{
-				subscriber: subscriber,
-				error:      err,
-			}
+	return s.publishDeliveries(ctx, deliveries)
+}
+
+// PublishAll is like Publish, but a failing subscriber does not prevent the following ones from receiving the event.
+// The errors of all the failing subscribers are returned together.
+func (s *subscriberList[T]) PublishAll(ctx context.Context, event T) error {
+	deliveries := s.deliveries(event)
+	defer releaseDeliveries(deliveries)
+
+	var errs *multierror.Error
+
+	for _, delivery := range deliveries {
+		if err := s.deliver(ctx, delivery); err != nil {
+			errs = multierror.Append(errs, err)
 		}
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
+// PublishParallel hands the event over to all the subscribers concurrently, so subscriber priorities are ignored.
+// A subscriber which panics is reported to the panic handler, and makes the publish fail as if it returned an error.
 func (s *subscriberList[T]) PublishParallel(
 	ctx context.Context,
 	event T,
 	panicHandler async.PanicHandler,
 ) error {
-	if len(s.subscribers) <= 1 {
-		return s.Publish(ctx, event)
+	deliveries := s.deliveries(event)
+	defer releaseDeliveries(deliveries)
+
+	if len(deliveries) <= 1 {
+		return s.publishDeliveries(ctx, deliveries)
+	}
+
+	err := parallel.DoContext(ctx, s.getPublishWorkers(), len(deliveries), func(ctx context.Context, index int) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				if panicHandler != nil {
+					panicHandler.HandlePanic(r)
+				}
+
+				err = &publishError[T]{
+					subscriber: deliveries[index].subscriber,
+					error:      fmt.Errorf("%w: %v", ErrSubscriberPanicked, r),
+				}
+			}
+		}()
+
+		return s.deliver(ctx, deliveries[index])
+	})
+
+	return err
+}
+
+// deliver hands the events of the delivery over to its subscriber, once the deliveries of the previous publishes to the
+// same subscriber are done, and then releases the delivery.
+func (s *subscriberList[T]) deliver(ctx context.Context, delivery delivery[T]) error {
+	defer delivery.release()
+
+	if err := delivery.gate.wait(ctx, delivery.ticket); err != nil {
+		return &publishError[T]{
+			subscriber: delivery.subscriber,
+			error:      err,
+		}
 	}
 
-	err := parallel.DoContext(ctx, runtime.NumCPU()/2, len(s.subscribers), func(ctx context.Context, index int) error {
-		defer async.HandlePanic(panicHandler)
-		if err := s.subscribers[index].handle(ctx, event); err != nil {
+	for _, event := range delivery.events {
+		if err := handle(ctx, delivery.opts, delivery.subscriber, event); err != nil {
 			return &publishError[T]{
-				subscriber: s.subscribers[index],
+				subscriber: delivery.subscriber,
 				error:      err,
 			}
 		}
+	}
 
-		return nil
-	})
+	return nil
+}
 
-	return err
+func (s *subscriberList[T]) publishDeliveries(ctx context.Context, deliveries []delivery[T]) error {
+	for _, delivery := range deliveries {
+		if err := s.deliver(ctx, delivery); err != nil {
+			return err
+		}
+
+		if err := ctx.Err(); err != nil {
+			return &publishError[T]{
+				subscriber: delivery.subscriber,
+				error:      err,
+			}
+		}
+	}
+
+	return nil
 }
 
 type ChanneledSubscriber[T any] struct {
-	id     string
-	sender chan *ChanneledSubscriberEvent[T]
+	id       string
+	sender   chan *ChanneledSubscriberEvent[T]
+	notReady atomic.Bool
+
+	// handleTimeout is the timeout for handling each event, in nanoseconds; 0 means none.
+	handleTimeout atomic.Int64
+
+	// When recordLastDelivered is set, last holds the last event handled successfully.
+	recordLastDelivered atomic.Bool
+	last                atomic.Pointer[T]
+
+	// onDropped, if set, is called with the events abandoned before the consumer received them.
+	onDropped atomic.Pointer[func(T)]
+
+	// drainGroup tracks the goroutine draining the events once the subscriber is cancelled.
+	drainGroup sync.WaitGroup
+
+	// queued are the events queued for the consumer which it did not handle yet, oldest first.
+	queued     []*ChanneledSubscriberEvent[T]
+	queuedLock sync.Mutex
+
+	// failed are the queued events the consumer failed to handle, which were not reported to the publisher yet.
+	failed     []*QueuedEventError[T]
+	failedLock sync.Mutex
+
+	// done is closed when the subscriber is closed, to abort the sends in progress. The sends hold closeLock for
+	// reading so that the channel is only closed once none is in progress.
+	done      chan struct{}
+	closeOnce sync.Once
+	closeLock sync.RWMutex
 }
 
 func newChanneledSubscriber[T any](name string) *ChanneledSubscriber[T] {
+	return newBufferedChanneledSubscriber[T](name, 0)
+}
+
+// newBufferedChanneledSubscriber creates a subscriber which queues up to bufferSize events for its consumer, so that
+// publishing does not wait for a busy consumer. Queued events are considered delivered once queued; if the consumer
+// fails to handle one, the next publish to the subscriber fails with a QueuedEventError instead of handing over its
+// event. When the queue is full, publishing waits for the consumer, as for an unbuffered subscriber.
+func newBufferedChanneledSubscriber[T any](name string, bufferSize int) *ChanneledSubscriber[T] {
 	return &ChanneledSubscriber[T]{
 		id:     name,
-		sender: make(chan *ChanneledSubscriberEvent[T]),
+		sender: make(chan *ChanneledSubscriberEvent[T], bufferSize),
+		done:   make(chan struct{}),
 	}
 }
 
 type ChanneledSubscriberEvent[T any] struct {
 	data     T
 	response chan error
+
+	// onHandled is set for queued events, whose publisher does not wait for the response.
+	onHandled func(error)
 }
 
 func (c ChanneledSubscriberEvent[T]) Consume(f func(T) error) {
-	if err := f(c.data); err != nil {
+	err := f(c.data)
+
+	if c.onHandled != nil {
+		c.onHandled(err)
+	} else if err != nil {
 		c.response <- err
 	}
+
 	close(c.response)
 }
 
@@ -160,16 +702,34 @@ func (c *ChanneledSubscriber[T]) name() string { //nolint:unused
 }
 
 func (c *ChanneledSubscriber[T]) handle(ctx context.Context, event T) error { //nolint:unused
+	// Report the failures of the events queued by the previous publishes first.
+	if err := c.takeFailures(); err != nil {
+		return err
+	}
+
+	// Queue the event if there is room for it.
+	if cap(c.sender) > 0 {
+		queued := &ChanneledSubscriberEvent[T]{
+			data:     event,
+			response: make(chan error, 1),
+		}
+		queued.onHandled = func(err error) { c.onQueuedEventHandled(queued, err) }
+
+		if c.enqueue(queued) {
+			return nil
+		}
+
+		// The queue is full, wait for the consumer.
+	}
+
 	data := &ChanneledSubscriberEvent[T]{
 		data:     event,
-		response: make(chan error),
+		response: make(chan error, 1),
 	}
 	// Send Event
-	select {
-	case <-ctx.Done():
-		return fmt.Errorf("failed to send event: %w", ctx.Err())
-	case c.sender <- data:
-		//
+	if err := c.send(ctx, data); err != nil {
+		c.dropped(event)
+		return err
 	}
 
 	// Wait on Reply
@@ -177,27 +737,233 @@ func (c *ChanneledSubscriber[T]) handle(ctx context.Context, event T) error { //
 	case <-ctx.Done():
 		return fmt.Errorf("failed to receive event reply: %w", ctx.Err())
 	case reply := <-data.response:
+		if errors.Is(reply, ErrSubscriberCancelled) {
+			c.dropped(event)
+		} else if reply == nil && c.recordLastDelivered.Load() {
+			c.last.Store(&event)
+		}
+
 		return reply
 	}
 }
 
+// send hands the event over to the consumer, unless the context is done or the subscriber is closed first.
+func (c *ChanneledSubscriber[T]) send(ctx context.Context, event *ChanneledSubscriberEvent[T]) error {
+	c.closeLock.RLock()
+	defer c.closeLock.RUnlock()
+
+	if c.isClosed() {
+		return ErrSubscriberCancelled
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("failed to send event: %w", ctx.Err())
+
+	case <-c.done:
+		return ErrSubscriberCancelled
+
+	case c.sender <- event:
+		return nil
+	}
+}
+
+func (c *ChanneledSubscriber[T]) isClosed() bool {
+	select {
+	case <-c.done:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// OnDropped registers a callback invoked with every event which is abandoned, due to the publish timing out or being
+// cancelled, or the subscriber being cancelled or closed, before the consumer handled it. It can be used to log the
+// event or schedule a resync.
+// The callback is invoked on the publishing goroutine, so it should not block. Passing nil removes the callback.
+func (c *ChanneledSubscriber[T]) OnDropped(fn func(event T)) {
+	if fn == nil {
+		c.onDropped.Store(nil)
+	} else {
+		c.onDropped.Store(&fn)
+	}
+}
+
+func (c *ChanneledSubscriber[T]) dropped(event T) {
+	if fn := c.onDropped.Load(); fn != nil {
+		(*fn)(event)
+	}
+}
+
+// enqueue queues the event for the consumer, if there is room for it.
+func (c *ChanneledSubscriber[T]) enqueue(event *ChanneledSubscriberEvent[T]) bool {
+	c.closeLock.RLock()
+	defer c.closeLock.RUnlock()
+
+	if c.isClosed() {
+		return false
+	}
+
+	c.queuedLock.Lock()
+	defer c.queuedLock.Unlock()
+
+	select {
+	case c.sender <- event:
+		c.queued = append(c.queued, event)
+		return true
+
+	default:
+		return false
+	}
+}
+
+func (c *ChanneledSubscriber[T]) onQueuedEventHandled(event *ChanneledSubscriberEvent[T], err error) {
+	c.queuedLock.Lock()
+	if index := slices.Index(c.queued, event); index >= 0 {
+		c.queued = slices.Delete(c.queued, index, index+1)
+	}
+	c.queuedLock.Unlock()
+
+	switch {
+	case errors.Is(err, ErrSubscriberCancelled):
+		// The subscriber is going away, the event was abandoned rather than handled.
+		c.dropped(event.data)
+
+	case err != nil:
+		c.failedLock.Lock()
+		c.failed = append(c.failed, &QueuedEventError[T]{Event: event.data, Err: err})
+		c.failedLock.Unlock()
+
+	case c.recordLastDelivered.Load():
+		c.last.Store(&event.data)
+	}
+}
+
+// takeFailures returns the failures of the queued events which were not reported yet, if any.
+func (c *ChanneledSubscriber[T]) takeFailures() error {
+	c.failedLock.Lock()
+	defer c.failedLock.Unlock()
+
+	if len(c.failed) == 0 {
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	for _, failed := range c.failed {
+		errs = multierror.Append(errs, failed)
+	}
+
+	c.failed = nil
+
+	if len(errs.Errors) == 1 {
+		return errs.Errors[0]
+	}
+
+	return errs
+}
+
+// Flush waits until the consumer has handled all the events currently queued, or the context is done, and returns how
+// many of them were handled. Events queued in the meantime are not waited for. Publishes to an unbuffered subscriber
+// are never queued, they wait for the consumer themselves.
+func (c *ChanneledSubscriber[T]) Flush(ctx context.Context) (int, error) {
+	c.queuedLock.Lock()
+	queued := slices.Clone(c.queued)
+	c.queuedLock.Unlock()
+
+	for flushed, event := range queued {
+		select {
+		case <-ctx.Done():
+			return flushed, ctx.Err()
+
+		case <-event.response:
+		}
+	}
+
+	return len(queued), nil
+}
+
 func (c *ChanneledSubscriber[T]) OnEventCh() <-chan *ChanneledSubscriberEvent[T] {
 	return c.sender
 }
 
+// SetReady marks whether the subscriber is able to handle events. Subscribers are ready by default.
+func (c *ChanneledSubscriber[T]) SetReady(ready bool) {
+	c.notReady.Store(!ready)
+}
+
+func (c *ChanneledSubscriber[T]) ready() bool { //nolint:unused
+	return !c.notReady.Load()
+}
+
+// SetTimeout sets how long the subscriber may take to handle an event, independently of the other subscribers.
+// A non-positive timeout, the default, only bounds handling by the publish context.
+func (c *ChanneledSubscriber[T]) SetTimeout(timeout time.Duration) {
+	c.handleTimeout.Store(int64(timeout))
+}
+
+func (c *ChanneledSubscriber[T]) timeout() time.Duration { //nolint:unused
+	return time.Duration(c.handleTimeout.Load())
+}
+
+// SetRecordLastDelivered sets whether the subscriber keeps the last event it handled successfully, for diagnostics.
+// It is disabled by default so that no event data is retained in normal operation; disabling it drops the kept event.
+func (c *ChanneledSubscriber[T]) SetRecordLastDelivered(enabled bool) {
+	c.recordLastDelivered.Store(enabled)
+
+	if !enabled {
+		c.last.Store(nil)
+	}
+}
+
+func (c *ChanneledSubscriber[T]) lastDelivered() (T, bool) { //nolint:unused
+	if last := c.last.Load(); last != nil {
+		return *last, true
+	}
+
+	var zero T
+
+	return zero, false
+}
+
+// close closes the event channel once the sends in progress are aborted. Later events are rejected with
+// ErrSubscriberCancelled.
 func (c *ChanneledSubscriber[T]) close() { //nolint:unused
-	close(c.sender)
+	c.closeOnce.Do(func() {
+		close(c.done)
+
+		c.closeLock.Lock()
+		defer c.closeLock.Unlock()
+
+		close(c.sender)
+	})
 }
 
-func (c *ChanneledSubscriber[T]) cancel() { //nolint:unused
+func (c *ChanneledSubscriber[T]) cancel(ctx context.Context) { //nolint:unused
+	c.drainGroup.Add(1)
+
 	go func() {
+		defer c.drainGroup.Done()
+
 		for {
-			e, ok := <-c.sender
-			if !ok {
+			select {
+			case <-ctx.Done():
 				return
-			}
 
-			e.Consume(func(_ T) error { return nil })
+			case e, ok := <-c.sender:
+				if !ok {
+					return
+				}
+
+				e.Consume(func(_ T) error { return ErrSubscriberCancelled })
+			}
 		}
 	}()
 }
+
+// waitDrained waits until the events are no longer drained after the subscriber was cancelled, i.e. until it is closed
+// or the cancel context is done.
+func (c *ChanneledSubscriber[T]) waitDrained() { //nolint:unused
+	c.drainGroup.Wait()
+}
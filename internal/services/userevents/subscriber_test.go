@@ -21,10 +21,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"runtime"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -103,3 +108,1140 @@ func TestChanneledSubscriber_ErrorReported(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestChanneledSubscriber_OnDropped(t *testing.T) {
+	subscriber := newChanneledSubscriber[int]("test")
+	defer subscriber.close()
+
+	var dropped []int
+
+	subscriber.OnDropped(func(event int) { dropped = append(dropped, event) })
+
+	// Nobody receives the event, so it is dropped when the publish times out.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, subscriber.handle(ctx, 1), context.DeadlineExceeded)
+	require.Equal(t, []int{1}, dropped)
+
+	// Same if the publish is cancelled.
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorIs(t, subscriber.handle(ctx, 2), context.Canceled)
+	require.Equal(t, []int{1, 2}, dropped)
+
+	// Events received by the consumer are not dropped, even if the reply comes too late.
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	go func() {
+		event := <-subscriber.OnEventCh()
+		time.Sleep(100 * time.Millisecond)
+		event.Consume(func(int) error { return nil })
+	}()
+
+	require.ErrorIs(t, subscriber.handle(ctx, 3), context.DeadlineExceeded)
+	require.Equal(t, []int{1, 2}, dropped)
+
+	// The callback can be removed.
+	subscriber.OnDropped(nil)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+
+	require.Error(t, subscriber.handle(ctx, 4))
+	require.Equal(t, []int{1, 2}, dropped)
+}
+
+func TestChanneledSubscriber_CancelRepliesCancelled(t *testing.T) {
+	subscriber := newChanneledSubscriber[int]("test")
+
+	var dropped []int
+
+	subscriber.OnDropped(func(event int) { dropped = append(dropped, event) })
+	subscriber.cancel(context.Background())
+
+	require.ErrorIs(t, subscriber.handle(context.Background(), 1), ErrSubscriberCancelled)
+
+	// The publisher does not fail, but the events are reported as dropped.
+	list := subscriberList[int]{}
+	list.Add(subscriber)
+
+	require.NoError(t, list.Publish(context.Background(), 2))
+	require.Equal(t, []int{1, 2}, dropped)
+
+	subscriber.close()
+	subscriber.waitDrained()
+}
+
+func TestChanneledSubscriber_CancelDrainStopsOnContextDone(t *testing.T) {
+	subscriber := newChanneledSubscriber[int]("test")
+	defer subscriber.close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	subscriber.cancel(ctx)
+	cancel()
+
+	// The drain stops even though the subscriber is never closed.
+	subscriber.waitDrained()
+}
+
+func TestChanneledSubscriber_CancelDoesNotLeakGoroutines(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 100; i++ {
+		subscriber := newChanneledSubscriber[int]("test")
+
+		subscriber.cancel(context.Background())
+		require.ErrorIs(t, subscriber.handle(context.Background(), i), ErrSubscriberCancelled)
+		subscriber.close()
+	}
+
+	// The drain goroutines exit asynchronously once the subscribers are closed.
+	require.Eventually(t, func() bool {
+		return runtime.NumGoroutine() <= before
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestSubscriberList_OnDropped(t *testing.T) {
+	list := subscriberList[int]{}
+
+	subscriber := newChanneledSubscriber[int]("test")
+	subscriber.SetTimeout(50 * time.Millisecond)
+
+	droppedCh := make(chan int, 1)
+	subscriber.OnDropped(func(event int) { droppedCh <- event })
+
+	list.Add(subscriber)
+	defer list.Remove(subscriber)
+
+	require.Error(t, list.Publish(context.Background(), 42))
+	require.Equal(t, 42, <-droppedCh)
+}
+
+func TestChanneledSubscriber_Buffered(t *testing.T) {
+	const bufferSize = 5
+
+	subscriber := newBufferedChanneledSubscriber[int]("test", bufferSize)
+	defer subscriber.close()
+
+	// The consumer is paused; events are queued without waiting for it.
+	for i := 0; i < bufferSize; i++ {
+		require.NoError(t, subscriber.handle(context.Background(), i))
+	}
+
+	// Once the queue is full, the publisher waits for the consumer again, within its context.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, subscriber.handle(ctx, -1), context.DeadlineExceeded)
+
+	errCh := make(chan error)
+
+	go func() { errCh <- subscriber.handle(context.Background(), bufferSize) }()
+
+	// Resume the consumer; no event is dropped.
+	for i := 0; i <= bufferSize; i++ {
+		event, ok := <-subscriber.OnEventCh()
+		require.True(t, ok)
+
+		event.Consume(func(event int) error {
+			require.Equal(t, i, event)
+			return nil
+		})
+	}
+
+	require.NoError(t, <-errCh)
+}
+
+func TestChanneledSubscriber_BufferedLastDelivered(t *testing.T) {
+	subscriber := newBufferedChanneledSubscriber[int]("test", 2)
+	defer subscriber.close()
+
+	subscriber.SetRecordLastDelivered(true)
+
+	require.NoError(t, subscriber.handle(context.Background(), 1))
+	require.NoError(t, subscriber.handle(context.Background(), 2))
+
+	// Queued events are only recorded once they are handled successfully.
+	_, ok := subscriber.lastDelivered()
+	require.False(t, ok)
+
+	(<-subscriber.OnEventCh()).Consume(func(int) error { return nil })
+	(<-subscriber.OnEventCh()).Consume(func(int) error { return errors.New("failed") })
+
+	last, ok := subscriber.lastDelivered()
+	require.True(t, ok)
+	require.Equal(t, 1, last)
+}
+
+func TestChanneledSubscriber_BufferedFailureIsReported(t *testing.T) {
+	list := subscriberList[int]{}
+	list.SetRetries(3, time.Millisecond)
+
+	subscriber := newBufferedChanneledSubscriber[int]("test", 5)
+	defer subscriber.close()
+
+	list.Add(subscriber)
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, list.Publish(context.Background(), i))
+	}
+
+	(<-subscriber.OnEventCh()).Consume(func(int) error { return nil })
+	(<-subscriber.OnEventCh()).Consume(func(int) error { return retryableTestError{} })
+	(<-subscriber.OnEventCh()).Consume(func(int) error { return nil })
+
+	// The next publish reports the failed event instead of queuing its own, and is not retried.
+	err := list.Publish(context.Background(), 4)
+
+	var publishErr *publishError[int]
+	require.ErrorAs(t, err, &publishErr)
+
+	var queuedErr *QueuedEventError[int]
+	require.ErrorAs(t, publishErr.error, &queuedErr)
+	require.Equal(t, 2, queuedErr.Event)
+	require.ErrorIs(t, queuedErr, retryableTestError{})
+
+	select {
+	case event := <-subscriber.OnEventCh():
+		require.Fail(t, "unexpected event", event)
+
+	default:
+	}
+
+	// The failure is only reported once.
+	require.NoError(t, list.Publish(context.Background(), 4))
+	(<-subscriber.OnEventCh()).Consume(func(event int) error {
+		require.Equal(t, 4, event)
+		return nil
+	})
+}
+
+func TestChanneledSubscriber_BufferedCancelledEventsAreDropped(t *testing.T) {
+	subscriber := newBufferedChanneledSubscriber[int]("test", 5)
+
+	var dropped []int
+
+	subscriber.OnDropped(func(event int) { dropped = append(dropped, event) })
+
+	require.NoError(t, subscriber.handle(context.Background(), 1))
+	require.NoError(t, subscriber.handle(context.Background(), 2))
+
+	// The queued events are abandoned when the subscriber is cancelled; they are dropped rather than failed.
+	subscriber.cancel(context.Background())
+	subscriber.close()
+	subscriber.waitDrained()
+
+	require.Equal(t, []int{1, 2}, dropped)
+	require.NoError(t, subscriber.takeFailures())
+}
+
+func TestChanneledSubscriber_Flush(t *testing.T) {
+	subscriber := newBufferedChanneledSubscriber[int]("test", 5)
+	defer subscriber.close()
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, subscriber.handle(context.Background(), i))
+	}
+
+	var (
+		lock      sync.Mutex
+		processed []int
+	)
+
+	// The consumer is slow to handle each event.
+	go func() {
+		for event := range subscriber.OnEventCh() {
+			event.Consume(func(event int) error {
+				time.Sleep(10 * time.Millisecond)
+
+				lock.Lock()
+				defer lock.Unlock()
+
+				processed = append(processed, event)
+
+				return nil
+			})
+		}
+	}()
+
+	flushed, err := subscriber.Flush(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, flushed)
+
+	// All the queued events were handled before Flush returned.
+	lock.Lock()
+	defer lock.Unlock()
+
+	require.Equal(t, []int{0, 1, 2}, processed)
+}
+
+func TestChanneledSubscriber_FlushTimeout(t *testing.T) {
+	subscriber := newBufferedChanneledSubscriber[int]("test", 5)
+	defer subscriber.close()
+
+	// Nothing is queued, there is nothing to wait for.
+	flushed, err := subscriber.Flush(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, flushed)
+
+	require.NoError(t, subscriber.handle(context.Background(), 1))
+	require.NoError(t, subscriber.handle(context.Background(), 2))
+
+	(<-subscriber.OnEventCh()).Consume(func(int) error { return nil })
+
+	// The consumer never handles the second event.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	flushed, err = subscriber.Flush(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Zero(t, flushed)
+}
+
+func TestSubscriberList_NotReadySubscriberIsSkipped(t *testing.T) {
+	list := subscriberList[int]{}
+
+	notReady := newRecordingSubscriber("not-ready")
+	notReady.SetReady(false)
+	ready := newRecordingSubscriber("ready")
+
+	list.Add(notReady)
+	list.Add(ready)
+
+	require.NoError(t, list.Publish(context.Background(), 1))
+	require.NoError(t, list.PublishParallel(context.Background(), 2, nil))
+	require.Empty(t, notReady.received())
+	require.Equal(t, []int{1, 2}, ready.received())
+
+	// Once ready, subsequently published events are received. Missed events are not buffered by default.
+	notReady.SetReady(true)
+
+	require.NoError(t, list.Publish(context.Background(), 3))
+	require.NoError(t, list.PublishParallel(context.Background(), 4, nil))
+	require.Equal(t, []int{3, 4}, notReady.received())
+	require.Equal(t, []int{1, 2, 3, 4}, ready.received())
+}
+
+func TestSubscriberList_NotReadySubscriberIsBuffered(t *testing.T) {
+	list := subscriberList[int]{}
+	list.SetNotReadyBufferSize(2)
+
+	subscriber := newRecordingSubscriber("test")
+	subscriber.SetReady(false)
+	list.Add(subscriber)
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, list.Publish(context.Background(), i))
+	}
+
+	require.Empty(t, subscriber.received())
+
+	// Only the most recent buffered events are kept, and delivered before the new one.
+	subscriber.SetReady(true)
+
+	require.NoError(t, list.Publish(context.Background(), 4))
+	require.Equal(t, []int{2, 3, 4}, subscriber.received())
+
+	require.NoError(t, list.Publish(context.Background(), 5))
+	require.Equal(t, []int{2, 3, 4, 5}, subscriber.received())
+}
+
+func TestSubscriberList_Priority(t *testing.T) {
+	list := subscriberList[int]{}
+
+	var order []string
+
+	newSubscriber := func(name string) *recordingSubscriber {
+		subscriber := newRecordingSubscriber(name)
+		subscriber.onHandle = func() { order = append(order, name) }
+
+		return subscriber
+	}
+
+	usedSpace := newSubscriber("used-space")
+	imap := newSubscriber("imap")
+	other := newSubscriber("other")
+	late := newSubscriber("late")
+
+	list.Add(usedSpace)
+	list.Add(other)
+	list.AddWithPriority(imap, 10)
+	list.AddWithPriority(late, -1)
+
+	require.NoError(t, list.Publish(context.Background(), 1))
+	require.Equal(t, []string{"imap", "used-space", "other", "late"}, order)
+
+	order = nil
+
+	require.NoError(t, list.PublishAll(context.Background(), 2))
+	require.Equal(t, []string{"imap", "used-space", "other", "late"}, order)
+
+	// Subscribers with the same priority keep their insertion order, also after removals.
+	order = nil
+
+	list.Remove(usedSpace)
+	list.AddWithPriority(usedSpace, 10)
+
+	require.NoError(t, list.Publish(context.Background(), 3))
+	require.Equal(t, []string{"imap", "used-space", "other", "late"}, order)
+}
+
+func TestSubscriberList_Names(t *testing.T) {
+	list := subscriberList[int]{}
+
+	require.Zero(t, list.Len())
+	require.Empty(t, list.Names())
+
+	messages := newRecordingSubscriber("messages")
+	labels := newRecordingSubscriber("labels")
+	addresses := newRecordingSubscriber("addresses")
+
+	list.Add(messages)
+	list.Add(labels)
+	list.Add(addresses)
+	list.Add(labels)
+
+	names := list.Names()
+	require.Equal(t, 3, list.Len())
+	require.Equal(t, []string{"messages", "labels", "addresses"}, names)
+
+	list.Remove(labels)
+
+	require.Equal(t, 2, list.Len())
+	require.Equal(t, []string{"messages", "addresses"}, list.Names())
+
+	// Earlier snapshots are not affected.
+	require.Equal(t, []string{"messages", "labels", "addresses"}, names)
+
+	list.Remove(messages)
+	list.Remove(addresses)
+
+	require.Zero(t, list.Len())
+	require.Empty(t, list.Names())
+}
+
+func TestSubscriberList_ConcurrentAddRemovePublish(t *testing.T) {
+	list := subscriberList[int]{}
+	list.SetNotReadyBufferSize(2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	// Publish continuously while subscribers come and go.
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for event := 0; ctx.Err() == nil; event++ {
+				_ = list.Publish(ctx, event)
+				_ = list.PublishAll(ctx, event)
+				_ = list.PublishParallel(ctx, event, nil)
+				_ = list.Names()
+				_ = list.Snapshot()
+			}
+		}()
+	}
+
+	for i := 0; i < 100; i++ {
+		recording := newRecordingSubscriber(fmt.Sprint("recording", i))
+		recording.SetReady(i%2 == 0)
+
+		// Removing a channeled subscriber closes its channel, which must not happen while it is being published to.
+		channeled := newChanneledSubscriber[int](fmt.Sprint("channeled", i))
+		channeled.SetReady(i%3 == 0)
+
+		consumed := make(chan struct{})
+
+		go func() {
+			defer close(consumed)
+
+			for event := range channeled.OnEventCh() {
+				event.Consume(func(int) error { return nil })
+			}
+		}()
+
+		list.Add(recording)
+		list.AddWithPriority(channeled, i%3)
+
+		list.Remove(recording)
+		list.Remove(channeled)
+
+		<-consumed
+	}
+
+	cancel()
+	wg.Wait()
+
+	require.Zero(t, list.Len())
+}
+
+func TestSubscriberList_HandlerRemovesItself(t *testing.T) {
+	list := subscriberList[int]{}
+
+	var self *FuncSubscriber[int]
+
+	self = NewFuncSubscriber("self", func(context.Context, int) error {
+		// Neither removing itself nor adding another subscriber waits for the publish in progress.
+		list.Remove(self)
+		list.Add(newRecordingSubscriber("other"))
+
+		return nil
+	})
+
+	list.Add(self)
+
+	for _, publish := range []func(context.Context, int) error{
+		list.Publish,
+		list.PublishAll,
+		func(ctx context.Context, event int) error { return list.PublishParallel(ctx, event, nil) },
+	} {
+		list.Add(self)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		require.NoError(t, publish(ctx, 1))
+		cancel()
+
+		require.NotContains(t, list.Names(), "self")
+	}
+}
+
+func TestChanneledSubscriber_CloseDuringSend(t *testing.T) {
+	list := subscriberList[int]{}
+
+	// Nobody consumes the events, so the publish is stuck sending when the subscriber is removed.
+	subscriber := newChanneledSubscriber[int]("test")
+
+	droppedCh := make(chan int, 1)
+	subscriber.OnDropped(func(event int) { droppedCh <- event })
+
+	list.Add(subscriber)
+
+	errCh := make(chan error)
+
+	go func() { errCh <- list.Publish(context.Background(), 1) }()
+
+	time.Sleep(50 * time.Millisecond)
+	list.Remove(subscriber)
+
+	// The publish is not stuck, and the event is reported as dropped.
+	require.NoError(t, <-errCh)
+	require.Equal(t, 1, <-droppedCh)
+
+	// The events handed over once closed are rejected.
+	require.ErrorIs(t, subscriber.handle(context.Background(), 2), ErrSubscriberCancelled)
+}
+
+type retryableTestError struct{}
+
+func (retryableTestError) Error() string   { return "database is locked" }
+func (retryableTestError) Retryable() bool { return true }
+
+func TestSubscriberList_Retries(t *testing.T) {
+	list := subscriberList[int]{}
+
+	flaky := newRecordingSubscriber("flaky")
+	flaky.failures = 2
+	next := newRecordingSubscriber("next")
+
+	list.Add(flaky)
+	list.Add(next)
+
+	// Without retries, the first failure aborts the publish.
+	var publishErr *publishError[int]
+
+	require.ErrorAs(t, list.Publish(context.Background(), 1), &publishErr)
+	require.Equal(t, retryableTestError{}, publishErr.error)
+	require.Empty(t, next.received())
+
+	// With retries, the subscriber which fails twice ultimately handles the event.
+	list.SetRetries(3, time.Millisecond)
+
+	require.NoError(t, list.Publish(context.Background(), 2))
+	require.Equal(t, []int{2}, flaky.received())
+	require.Equal(t, []int{2}, next.received())
+
+	// Retries are limited.
+	flaky.failures = 4
+
+	require.ErrorAs(t, list.Publish(context.Background(), 3), &publishErr)
+	require.Equal(t, retryableTestError{}, publishErr.error)
+	require.Equal(t, []int{2}, flaky.received())
+
+	// Permanent errors are not retried.
+	flaky.failures = 0
+	flaky.err = errors.New("permanent failure")
+
+	require.ErrorAs(t, list.Publish(context.Background(), 4), &publishErr)
+	require.Equal(t, flaky.err, publishErr.error)
+	require.Equal(t, []int{2, 4}, flaky.received())
+}
+
+func TestSubscriberList_SettingsChangedWhilePublishing(t *testing.T) {
+	list := subscriberList[int]{}
+	list.Add(newRecordingSubscriber("subscriber"))
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for i := 0; i < 100; i++ {
+			list.SetRetries(i, time.Millisecond)
+			list.SetLogger(logrus.WithField("settings", i))
+		}
+	}()
+
+	// The settings are read along with the subscribers, so the race detector finds no race here.
+	for i := 0; i < 100; i++ {
+		require.NoError(t, list.Publish(context.Background(), i))
+	}
+
+	<-done
+}
+
+func TestSubscriberList_RetriesStopOnCancel(t *testing.T) {
+	list := subscriberList[int]{}
+	list.SetRetries(10, time.Hour)
+
+	flaky := newRecordingSubscriber("flaky")
+	flaky.failures = 1
+
+	list.Add(flaky)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	var publishErr *publishError[int]
+
+	require.ErrorAs(t, list.Publish(ctx, 1), &publishErr)
+	require.Equal(t, retryableTestError{}, publishErr.error)
+	require.Empty(t, flaky.received())
+}
+
+func TestSubscriberList_Logging(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	logger.SetLevel(logrus.DebugLevel)
+
+	list := subscriberList[int]{}
+	list.SetLogger(logrus.NewEntry(logger))
+
+	ok := newRecordingSubscriber("ok")
+	failing := newRecordingSubscriber("failing")
+	failing.err = errors.New("failed to handle event")
+
+	list.Add(ok)
+	list.Add(failing)
+
+	require.Error(t, list.Publish(context.Background(), 1))
+	require.Len(t, hook.AllEntries(), 2)
+
+	success := hook.AllEntries()[0]
+	require.Equal(t, logrus.DebugLevel, success.Level)
+	require.Equal(t, "ok", success.Data["subscriber"])
+	require.Equal(t, "int", success.Data["eventType"])
+	require.IsType(t, time.Duration(0), success.Data["duration"])
+	require.NotContains(t, success.Data, "items")
+
+	failure := hook.AllEntries()[1]
+	require.Equal(t, logrus.WarnLevel, failure.Level)
+	require.Equal(t, "failing", failure.Data["subscriber"])
+	require.Equal(t, "int", failure.Data["eventType"])
+	require.IsType(t, time.Duration(0), failure.Data["duration"])
+	require.Equal(t, failing.err, failure.Data[logrus.ErrorKey])
+
+	// Parallel publishes are logged too.
+	hook.Reset()
+
+	require.Error(t, list.PublishParallel(context.Background(), 2, nil))
+	require.NotEmpty(t, hook.AllEntries())
+
+	for _, entry := range hook.AllEntries() {
+		require.Contains(t, []string{"ok", "failing"}, entry.Data["subscriber"])
+		require.Contains(t, entry.Data, "duration")
+	}
+}
+
+func TestSubscriberList_LoggingSliceEvents(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	logger.SetLevel(logrus.DebugLevel)
+
+	list := subscriberList[[]string]{}
+	list.SetLogger(logrus.NewEntry(logger))
+
+	subscriber := newChanneledSubscriber[[]string]("messages")
+	list.Add(subscriber)
+
+	go func() {
+		for event := range subscriber.OnEventCh() {
+			event.Consume(func([]string) error { return nil })
+		}
+	}()
+
+	defer list.Remove(subscriber)
+
+	require.NoError(t, list.Publish(context.Background(), []string{"a", "b", "c"}))
+
+	entry := hook.LastEntry()
+	require.Equal(t, logrus.DebugLevel, entry.Level)
+	require.Equal(t, "messages", entry.Data["subscriber"])
+	require.Equal(t, "[]string", entry.Data["eventType"])
+	require.Equal(t, 3, entry.Data["items"])
+}
+
+func TestSubscriberList_PublishWorkers(t *testing.T) {
+	for _, workers := range []int{1, 2, 4} {
+		t.Run(fmt.Sprint(workers), func(t *testing.T) {
+			list := subscriberList[int]{}
+			list.SetPublishWorkers(workers)
+
+			var running, maxRunning atomic.Int32
+
+			for i := 0; i < 8; i++ {
+				subscriber := newRecordingSubscriber(fmt.Sprint(i))
+				subscriber.delay = 20 * time.Millisecond
+				subscriber.onStart = func() {
+					n := running.Add(1)
+
+					for prev := maxRunning.Load(); n > prev && !maxRunning.CompareAndSwap(prev, n); prev = maxRunning.Load() {
+					}
+				}
+				subscriber.onHandle = func() { running.Add(-1) }
+
+				list.Add(subscriber)
+			}
+
+			require.NoError(t, list.PublishParallel(context.Background(), 1, nil))
+
+			// The subscribers are never handed the event by more than the given number of workers at once.
+			require.LessOrEqual(t, maxRunning.Load(), int32(workers))
+			require.Positive(t, maxRunning.Load())
+		})
+	}
+}
+
+func TestSubscriberList_PublishWorkersDefault(t *testing.T) {
+	list := subscriberList[int]{}
+
+	// The default is half the number of CPUs, but never zero.
+	require.Equal(t, defaultPublishWorkers(), list.getPublishWorkers())
+	require.GreaterOrEqual(t, list.getPublishWorkers(), 1)
+
+	list.SetPublishWorkers(3)
+	require.Equal(t, 3, list.getPublishWorkers())
+
+	// Zero or negative overrides fall back to the default.
+	for _, workers := range []int{0, -1} {
+		list.SetPublishWorkers(workers)
+		require.Equal(t, defaultPublishWorkers(), list.getPublishWorkers())
+		require.GreaterOrEqual(t, list.getPublishWorkers(), 1)
+	}
+}
+
+func TestSubscriberList_OverlappingPublishesInOrder(t *testing.T) {
+	list := subscriberList[int]{}
+
+	started, unblock := make(chan struct{}), make(chan struct{})
+
+	var once sync.Once
+
+	subscriber := newRecordingSubscriber("test")
+	subscriber.onStart = func() {
+		// Only the first event blocks, until the second publish is underway.
+		once.Do(func() {
+			close(started)
+			<-unblock
+		})
+	}
+
+	list.Add(subscriber)
+
+	var wg sync.WaitGroup
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		require.NoError(t, list.Publish(context.Background(), 1))
+	}()
+
+	<-started
+
+	go func() {
+		defer wg.Done()
+		require.NoError(t, list.PublishParallel(context.Background(), 2, nil))
+	}()
+
+	// The second publish waits for the first one to be handled by the subscriber.
+	require.Never(t, func() bool { return len(subscriber.received()) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+
+	close(unblock)
+	wg.Wait()
+
+	require.Equal(t, []int{1, 2}, subscriber.received())
+}
+
+func TestSubscriberList_AbandonedPublishDoesNotBlockOthers(t *testing.T) {
+	list := subscriberList[int]{}
+
+	started, unblock := make(chan struct{}), make(chan struct{})
+
+	var once sync.Once
+
+	subscriber := newRecordingSubscriber("test")
+	subscriber.onStart = func() {
+		once.Do(func() {
+			close(started)
+			<-unblock
+		})
+	}
+
+	list.Add(subscriber)
+
+	done := make(chan error)
+
+	go func() { done <- list.Publish(context.Background(), 1) }()
+
+	<-started
+
+	// The second publish gives up while waiting for its turn.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	require.ErrorContains(t, list.PublishAll(ctx, 2), context.DeadlineExceeded.Error())
+
+	go func() { done <- list.Publish(context.Background(), 3) }()
+
+	close(unblock)
+
+	require.NoError(t, <-done)
+	require.NoError(t, <-done)
+
+	require.Equal(t, []int{1, 3}, subscriber.received())
+}
+
+func TestSubscriberList_FuncSubscriber(t *testing.T) {
+	list := subscriberList[int]{}
+
+	var received []int
+
+	list.Add(NewFuncSubscriber[int]("func", func(ctx context.Context, event int) error {
+		received = append(received, event)
+		return ctx.Err()
+	}))
+
+	require.NoError(t, list.Publish(context.Background(), 1))
+	require.NoError(t, list.PublishParallel(context.Background(), 2, nil))
+
+	// The function is handed the publish context.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorContains(t, list.Publish(ctx, 3), context.Canceled.Error())
+	require.Equal(t, []int{1, 2, 3}, received)
+}
+
+type recordingPanicHandler struct {
+	lock      sync.Mutex
+	recovered []any
+}
+
+func (h *recordingPanicHandler) HandlePanic(r any) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.recovered = append(h.recovered, r)
+}
+
+func TestSubscriberList_PublishParallelPanic(t *testing.T) {
+	list := subscriberList[int]{}
+
+	healthy := newRecordingSubscriber("healthy")
+
+	list.Add(healthy)
+	list.Add(NewFuncSubscriber[int]("panicking", func(context.Context, int) error {
+		panic("oops")
+	}))
+
+	panicHandler := &recordingPanicHandler{}
+
+	err := list.PublishParallel(context.Background(), 1, panicHandler)
+	require.Error(t, err)
+
+	// The error identifies the subscriber which panicked.
+	var publishErr *publishError[int]
+	require.ErrorAs(t, err, &publishErr)
+	require.Equal(t, "panicking", publishErr.subscriber.name())
+	require.ErrorIs(t, publishErr.error, ErrSubscriberPanicked)
+
+	// The panic is still reported, and does not prevent the other subscribers from handling the event.
+	require.Equal(t, []any{"oops"}, panicHandler.recovered)
+	require.Equal(t, []int{1}, healthy.received())
+}
+
+func TestSubscriberList_PublishAll(t *testing.T) {
+	list := subscriberList[int]{}
+
+	first := newRecordingSubscriber("first")
+	failing := newRecordingSubscriber("failing")
+	failing.err = errors.New("failed to handle event")
+	last := newRecordingSubscriber("last")
+
+	list.Add(first)
+	list.Add(failing)
+	list.Add(last)
+
+	// Publish stops at the failing subscriber.
+	err := list.Publish(context.Background(), 1)
+	require.Error(t, err)
+	require.Equal(t, []int{1}, first.received())
+	require.Empty(t, last.received())
+
+	// PublishAll delivers the event to the subscribers after the failing one.
+	err = list.PublishAll(context.Background(), 2)
+	require.Equal(t, []int{1, 2}, first.received())
+	require.Equal(t, []int{1, 2}, failing.received())
+	require.Equal(t, []int{2}, last.received())
+
+	var publishErr *publishError[int]
+	require.ErrorAs(t, err, &publishErr)
+	require.Equal(t, "failing", publishErr.subscriber.name())
+	require.Equal(t, failing.err, publishErr.error)
+
+	// Without failures, no error is returned.
+	failing.err = nil
+
+	require.NoError(t, list.PublishAll(context.Background(), 3))
+	require.Equal(t, []int{2, 3}, last.received())
+}
+
+func TestSubscriberList_PublishAll_CollectsErrors(t *testing.T) {
+	list := subscriberList[int]{}
+
+	failing1 := newRecordingSubscriber("failing1")
+	failing1.err = errors.New("first failure")
+	ok := newRecordingSubscriber("ok")
+	failing2 := newRecordingSubscriber("failing2")
+	failing2.err = errors.New("second failure")
+
+	list.Add(failing1)
+	list.Add(ok)
+	list.Add(failing2)
+
+	err := list.PublishAll(context.Background(), 1)
+	require.Equal(t, []int{1}, ok.received())
+
+	var merr *multierror.Error
+	require.ErrorAs(t, err, &merr)
+	require.Len(t, merr.Errors, 2)
+
+	for i, failing := range []*recordingSubscriber{failing1, failing2} {
+		var publishErr *publishError[int]
+		require.ErrorAs(t, merr.Errors[i], &publishErr)
+		require.Equal(t, failing.name(), publishErr.subscriber.name())
+		require.Equal(t, failing.err, publishErr.error)
+	}
+}
+
+func TestSubscriberList_PerSubscriberTimeout(t *testing.T) {
+	list := subscriberList[int]{}
+
+	// The slow subscriber exceeds its own timeout, the fast one is legitimately slower than it, but within its timeout.
+	slow := newRecordingSubscriber("slow")
+	slow.delay = time.Second
+	slow.handleTimeout = 50 * time.Millisecond
+
+	fast := newRecordingSubscriber("fast")
+	fast.delay = 100 * time.Millisecond
+	fast.handleTimeout = time.Second
+
+	list.Add(slow)
+	list.Add(fast)
+
+	err := list.PublishAll(context.Background(), 1)
+
+	var publishErr *publishError[int]
+	require.ErrorAs(t, err, &publishErr)
+	require.Equal(t, "slow", publishErr.subscriber.name())
+	require.ErrorIs(t, publishErr.error, context.DeadlineExceeded)
+
+	require.Empty(t, slow.received())
+	require.Equal(t, []int{1}, fast.received())
+
+	// The subscriber timeouts do not extend the publish context.
+	list.Remove(slow)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err = list.PublishAll(ctx, 2)
+	require.ErrorAs(t, err, &publishErr)
+	require.ErrorIs(t, publishErr.error, context.DeadlineExceeded)
+	require.Equal(t, []int{1}, fast.received())
+}
+
+func TestChanneledSubscriber_Timeout(t *testing.T) {
+	subscriber := newChanneledSubscriber[int]("test")
+	defer subscriber.close()
+
+	subscriber.SetTimeout(50 * time.Millisecond)
+
+	// Nobody receives the event, so the subscriber's own timeout is hit rather than the publish context's.
+	start := time.Now()
+
+	err := handleWithTimeout[int](context.Background(), subscriber, 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestChanneledSubscriber_LastDelivered(t *testing.T) {
+	subscriber := newChanneledSubscriber[int]("test")
+	defer subscriber.close()
+
+	subscriber.SetRecordLastDelivered(true)
+
+	list := subscriberList[int]{}
+	list.Add(subscriber)
+
+	// Nothing was delivered yet.
+	_, ok := subscriber.lastDelivered()
+	require.False(t, ok)
+	require.Empty(t, list.Snapshot())
+
+	// Handle events with the given reply.
+	consume := func(err error) {
+		event, ok := <-subscriber.OnEventCh()
+		require.True(t, ok)
+		event.Consume(func(int) error { return err })
+	}
+
+	go consume(nil)
+	require.NoError(t, subscriber.handle(context.Background(), 10))
+
+	go consume(nil)
+	require.NoError(t, subscriber.handle(context.Background(), 20))
+
+	// The most recent successfully handled event is kept.
+	last, ok := subscriber.lastDelivered()
+	require.True(t, ok)
+	require.Equal(t, 20, last)
+	require.Equal(t, map[string]int{"test": 20}, list.Snapshot())
+
+	// Failed events are not recorded.
+	go consume(fmt.Errorf("failed"))
+	require.Error(t, subscriber.handle(context.Background(), 30))
+
+	last, ok = subscriber.lastDelivered()
+	require.True(t, ok)
+	require.Equal(t, 20, last)
+
+	// Disabling the recording drops the kept event.
+	subscriber.SetRecordLastDelivered(false)
+
+	_, ok = subscriber.lastDelivered()
+	require.False(t, ok)
+	require.Empty(t, list.Snapshot())
+}
+
+func TestChanneledSubscriber_LastDeliveredDisabledByDefault(t *testing.T) {
+	subscriber := newChanneledSubscriber[int]("test")
+	defer subscriber.close()
+
+	go func() {
+		event, ok := <-subscriber.OnEventCh()
+		require.True(t, ok)
+		event.Consume(func(int) error { return nil })
+	}()
+
+	require.NoError(t, subscriber.handle(context.Background(), 10))
+
+	_, ok := subscriber.lastDelivered()
+	require.False(t, ok)
+}
+
+type recordingSubscriber struct {
+	id       string
+	notReady atomic.Bool
+	err      error
+
+	// delay is how long handling an event takes, and handleTimeout the subscriber's own timeout for it.
+	delay         time.Duration
+	handleTimeout time.Duration
+
+	// onStart, if set, is called whenever the handling of an event starts, and onHandle whenever an event is handled.
+	onStart  func()
+	onHandle func()
+
+	// failures is the number of next events which fail with a retryable error, without being recorded.
+	failures int
+
+	lock   sync.Mutex
+	events []int
+}
+
+func newRecordingSubscriber(name string) *recordingSubscriber {
+	return &recordingSubscriber{id: name}
+}
+
+func (r *recordingSubscriber) SetReady(ready bool) {
+	r.notReady.Store(!ready)
+}
+
+func (r *recordingSubscriber) received() []int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.events
+}
+
+func (r *recordingSubscriber) name() string {
+	return r.id
+}
+
+func (r *recordingSubscriber) handle(ctx context.Context, event int) error {
+	if r.onStart != nil {
+		r.onStart()
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+
+	case <-time.After(r.delay):
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.failures > 0 {
+		r.failures--
+		return retryableTestError{}
+	}
+
+	r.events = append(r.events, event)
+
+	if r.onHandle != nil {
+		r.onHandle()
+	}
+
+	return r.err
+}
+
+func (r *recordingSubscriber) timeout() time.Duration {
+	return r.handleTimeout
+}
+
+func (r *recordingSubscriber) ready() bool {
+	return !r.notReady.Load()
+}
+
+func (r *recordingSubscriber) cancel(_ context.Context) {}
+
+func (r *recordingSubscriber) close() {}
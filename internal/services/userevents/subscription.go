@@ -30,6 +30,12 @@ func NewEventSubscriber(name string) *EventChanneledSubscriber {
 	return newSubscriber(name)
 }
 
+// NewBufferedEventSubscriber is like NewEventSubscriber, but up to bufferSize events are queued for the consumer instead
+// of making the event publisher wait for it.
+func NewBufferedEventSubscriber(name string, bufferSize int) *EventChanneledSubscriber {
+	return newBufferedSubscriber(name, bufferSize)
+}
+
 type EventHandler struct {
 	RefreshHandler      RefreshEventHandler
 	AddressHandler      AddressEventHandler
@@ -38,6 +44,10 @@ type EventHandler struct {
 	MessageHandler      MessageEventHandler
 	UsedSpaceHandler    UserUsedSpaceEventHandler
 	UserSettingsHandler UserSettingsHandler
+
+	// MessageCheckpoint, if set, lets a MessageHandler implementing PartialMessageEventHandler resume an event whose
+	// message events were only partially handled rather than handle all of them again.
+	MessageCheckpoint *MessageCheckpoint
 }
 
 func (e EventHandler) OnEvent(ctx context.Context, event proton.Event) error {
@@ -75,7 +85,7 @@ func (e EventHandler) OnEvent(ctx context.Context, event proton.Event) error {
 
 	// Next message events
 	if len(event.Messages) != 0 && e.MessageHandler != nil {
-		if err := e.MessageHandler.HandleMessageEvents(ctx, event.Messages); err != nil {
+		if err := e.handleMessageEvents(ctx, event); err != nil {
 			return fmt.Errorf("failed to apply message events: %w", err)
 		}
 	}
@@ -90,6 +100,14 @@ func (e EventHandler) OnEvent(ctx context.Context, event proton.Event) error {
 	return nil
 }
 
+func (e EventHandler) handleMessageEvents(ctx context.Context, event proton.Event) error {
+	if partial, ok := e.MessageHandler.(PartialMessageEventHandler); ok && e.MessageCheckpoint != nil {
+		return e.MessageCheckpoint.handle(ctx, partial, event.EventID, event.Messages)
+	}
+
+	return e.MessageHandler.HandleMessageEvents(ctx, event.Messages)
+}
+
 type RefreshEventHandler interface {
 	HandleRefreshEvent(ctx context.Context, flag proton.RefreshFlag) error
 }
@@ -116,3 +134,12 @@ type LabelEventHandler interface {
 type MessageEventHandler interface {
 	HandleMessageEvents(ctx context.Context, events []proton.MessageEvent) error
 }
+
+// PartialMessageEventHandler is a MessageEventHandler which reports how far it got through the message events.
+type PartialMessageEventHandler interface {
+	MessageEventHandler
+
+	// HandlePartial handles the message events in order. It returns how many were handled, i.e. the index of the
+	// first event which was not, and the error which stopped it, if any.
+	HandlePartial(ctx context.Context, events []proton.MessageEvent) (int, error)
+}
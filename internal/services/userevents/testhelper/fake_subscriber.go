@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package testhelper provides test doubles for the users of the userevents package.
+package testhelper
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/userevents"
+	"github.com/stretchr/testify/require"
+)
+
+// FakeSubscriber is a subscriber recording the events it handles. It can be made to fail, or to take some time to
+// handle each event.
+type FakeSubscriber[T any] struct {
+	*userevents.FuncSubscriber[T]
+
+	lock   sync.Mutex
+	events []T
+	err    error
+	delay  time.Duration
+}
+
+// NewFakeSubscriber creates a subscriber which records the events it handles. It can be registered wherever a
+// subscriber is expected, e.g. with userevents.Service.Subscribe.
+func NewFakeSubscriber[T any](name string) *FakeSubscriber[T] {
+	fake := &FakeSubscriber[T]{}
+	fake.FuncSubscriber = userevents.NewFuncSubscriber[T](name, fake.Handle)
+
+	return fake
+}
+
+// SetError sets the error returned when handling the following events, which are then not recorded. Passing nil
+// makes the subscriber succeed again.
+func (f *FakeSubscriber[T]) SetError(err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.err = err
+}
+
+// SetDelay sets how long handling each of the following events takes. The wait stops if the publish context is done.
+func (f *FakeSubscriber[T]) SetDelay(delay time.Duration) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.delay = delay
+}
+
+// Handle handles the event as a publish would, recording it unless an error is injected or the context is done first.
+func (f *FakeSubscriber[T]) Handle(ctx context.Context, event T) error {
+	f.lock.Lock()
+	delay, err := f.delay, f.err
+	f.lock.Unlock()
+
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-timer.C:
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.events = append(f.events, event)
+
+	return nil
+}
+
+// Received returns the events handled successfully so far, oldest first.
+func (f *FakeSubscriber[T]) Received() []T {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	events := make([]T, len(f.events))
+	copy(events, f.events)
+
+	return events
+}
+
+// Reset forgets the events handled so far.
+func (f *FakeSubscriber[T]) Reset() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.events = nil
+}
+
+// RequireReceived fails the test unless exactly the given events were handled successfully, in order.
+func (f *FakeSubscriber[T]) RequireReceived(t require.TestingT, expected ...T) {
+	if expected == nil {
+		expected = []T{}
+	}
+
+	require.Equal(t, expected, f.Received())
+}
+
+// RequireEventuallyReceived fails the test unless the given number of events is handled successfully within the
+// timeout, e.g. when they are published from another goroutine.
+func (f *FakeSubscriber[T]) RequireEventuallyReceived(t require.TestingT, count int, timeout time.Duration) {
+	require.Eventually(t, func() bool { return len(f.Received()) >= count }, timeout, time.Millisecond)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package testhelper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/userevents"
+	"github.com/stretchr/testify/require"
+)
+
+// The fake can be subscribed to the events service.
+var _ userevents.EventSubscriber = NewFakeSubscriber[proton.Event]("fake")
+
+func TestFakeSubscriber_RecordsEvents(t *testing.T) {
+	fake := NewFakeSubscriber[int]("fake")
+
+	fake.RequireReceived(t)
+
+	require.NoError(t, fake.Handle(context.Background(), 1))
+	require.NoError(t, fake.Handle(context.Background(), 2))
+
+	fake.RequireReceived(t, 1, 2)
+
+	fake.Reset()
+	fake.RequireReceived(t)
+}
+
+func TestFakeSubscriber_InjectedError(t *testing.T) {
+	fake := NewFakeSubscriber[int]("fake")
+
+	injected := errors.New("failed to handle event")
+	fake.SetError(injected)
+
+	require.ErrorIs(t, fake.Handle(context.Background(), 1), injected)
+
+	// Failed events are not recorded.
+	fake.RequireReceived(t)
+
+	fake.SetError(nil)
+
+	require.NoError(t, fake.Handle(context.Background(), 2))
+	fake.RequireReceived(t, 2)
+}
+
+func TestFakeSubscriber_DelayRespectsContext(t *testing.T) {
+	fake := NewFakeSubscriber[int]("fake")
+	fake.SetDelay(time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	require.ErrorIs(t, fake.Handle(ctx, 1), context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Second)
+
+	fake.RequireReceived(t)
+}
+
+func TestFakeSubscriber_Delay(t *testing.T) {
+	fake := NewFakeSubscriber[int]("fake")
+	fake.SetDelay(50 * time.Millisecond)
+
+	go func() { _ = fake.Handle(context.Background(), 1) }()
+
+	fake.RequireReceived(t)
+	fake.RequireEventuallyReceived(t, 1, time.Second)
+	fake.RequireReceived(t, 1)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+
+package user
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// GetSendRecorderStatePath returns the path of the file in which the send recorder of the given user is persisted.
+func GetSendRecorderStatePath(dir, userID string) string {
+	return filepath.Join(dir, fmt.Sprintf("send-%v", userID))
+}
+
+// DeleteSendRecorderState removes the persisted send recorder of the given user, if any.
+func DeleteSendRecorderState(dir, userID string) error {
+	if err := os.Remove(GetSendRecorderStatePath(dir, userID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
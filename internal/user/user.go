@@ -62,10 +62,11 @@ type User struct {
 	id  string
 	log *logrus.Entry
 
-	vault    *vault.User
-	client   *proton.Client
-	reporter reporter.Reporter
-	sendHash *sendrecorder.SendRecorder
+	vault     *vault.User
+	client    *proton.Client
+	reporter  reporter.Reporter
+	sendHash  *sendrecorder.SendRecorder
+	sendStore *sendrecorder.FileStore
 
 	eventCh   *async.QueuedChannel[events.Event]
 	eventLock safe.RWMutex
@@ -105,6 +106,7 @@ func New(
 	eventSubscription events.Subscription,
 	syncService syncservice.Regulator,
 	syncConfigDir string,
+	sendRecorderDir string,
 	isNew bool,
 ) (*User, error) {
 	user, err := newImpl(
@@ -123,6 +125,7 @@ func New(
 		eventSubscription,
 		syncService,
 		syncConfigDir,
+		sendRecorderDir,
 		isNew,
 	)
 	if err != nil {
@@ -154,6 +157,7 @@ func newImpl(
 	eventSubscription events.Subscription,
 	syncService syncservice.Regulator,
 	syncConfigDir string,
+	sendRecorderDir string,
 	isNew bool,
 ) (*User, error) {
 	logrus.WithField("userID", apiUser.ID).Info("Creating new user")
@@ -189,17 +193,29 @@ func newImpl(
 		return nil, fmt.Errorf("failed to init configuration status file: %w", err)
 	}
 
-	sendRecorder := sendrecorder.NewSendRecorder(sendrecorder.SendEntryExpiry)
+	// The messages sent are recorded on disk, so that they are still deduplicated after a restart.
+	sendStore := sendrecorder.NewFileStore(GetSendRecorderStatePath(sendRecorderDir, apiUser.ID), true)
+
+	sendRecorder, err := sendrecorder.NewSendRecorderWithStore(sendrecorder.SendEntryExpiry, sendrecorder.SendMaxEntries, sendStore)
+	if err != nil {
+		logrus.WithError(err).WithField("userID", apiUser.ID).Warn("Failed to load send recorder state, starting afresh")
+
+		sendStore = nil
+		sendRecorder = sendrecorder.NewSendRecorder(sendrecorder.SendEntryExpiry, sendrecorder.SendMaxEntries)
+	}
+
+	sendRecorder.SetEnabled(encVault.SendDedupEnabled())
 
 	// Create the user object.
 	user := &User{
 		log: logrus.WithField("userID", apiUser.ID),
 		id:  apiUser.ID,
 
-		vault:    encVault,
-		client:   client,
-		reporter: reporter,
-		sendHash: sendRecorder,
+		vault:     encVault,
+		client:    client,
+		reporter:  reporter,
+		sendHash:  sendRecorder,
+		sendStore: sendStore,
 
 		eventCh:   async.NewQueuedChannel[events.Event](0, 0, crashHandler, fmt.Sprintf("bridge-user-%v", apiUser.ID)),
 		eventLock: safe.NewRWMutex(),
@@ -244,6 +260,7 @@ func newImpl(
 		encVault,
 		encVault,
 		user,
+		user,
 		user.eventService,
 		addressMode,
 		identityState.Clone(),
@@ -407,6 +424,36 @@ func (user *User) Emails() []string {
 	})
 }
 
+// GetSendDedupEnabled returns whether duplicate sends are detected for the user.
+func (user *User) GetSendDedupEnabled() bool {
+	return user.vault.SendDedupEnabled()
+}
+
+// SetSendDedupEnabled sets whether duplicate sends are detected for the user.
+// When disabled, identical messages are always sent, and appended messages are never matched against sent ones.
+func (user *User) SetSendDedupEnabled(enabled bool) error {
+	user.log.WithField("enabled", enabled).Info("Setting send deduplication")
+
+	if err := user.vault.SetSendDedupEnabled(enabled); err != nil {
+		return fmt.Errorf("failed to set send deduplication: %w", err)
+	}
+
+	user.sendHash.SetEnabled(enabled)
+
+	return nil
+}
+
+// WaitAllInFlight waits for the messages being sent by the user to complete, until the context is done.
+// It returns the number of messages which were still being sent.
+func (user *User) WaitAllInFlight(ctx context.Context) int {
+	return user.sendHash.WaitAllInFlight(ctx)
+}
+
+// SendRecorderSnapshot returns the sanitized entries of the user's send recorder, for diagnostics.
+func (user *User) SendRecorderSnapshot() []sendrecorder.EntrySnapshot {
+	return user.sendHash.Snapshot()
+}
+
 // GetAddressMode returns the user's current address mode.
 func (user *User) GetAddressMode() vault.AddressMode {
 	return user.vault.AddressMode()
@@ -631,6 +678,13 @@ func (user *User) Close() {
 	// Close the user's API client.
 	user.client.Close()
 
+	// Write the pending changes of the send recorder.
+	if user.sendStore != nil {
+		if err := user.sendStore.Flush(); err != nil {
+			user.log.WithError(err).Error("Failed to write send recorder state")
+		}
+	}
+
 	// Close the user's notify channel.
 	user.eventCh.CloseAndDiscardQueued()
 
@@ -698,6 +752,11 @@ func (user *User) ResumeEventLoop() {
 	user.eventService.Resume()
 }
 
+// PollEventsNow polls the user's events right away, unless it was already requested shortly before.
+func (user *User) PollEventsNow() bool {
+	return user.eventService.PollNow()
+}
+
 func (user *User) protonAddresses() []proton.Address {
 	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Minute))
 	defer cancel()
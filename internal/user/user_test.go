@@ -164,7 +164,8 @@ func withUser(tb testing.TB, ctx context.Context, _ *server.Server, m *proton.Ma
 		nullSMTPServerManager,
 		nullEventSubscription,
 		nil,
-		"",
+		tb.TempDir(),
+		tb.TempDir(),
 		true,
 	)
 	require.NoError(tb, err)
@@ -46,6 +46,13 @@ func (vault *Vault) GetBridgeTLSCert() ([]byte, []byte) {
 	return certs.Bridge.Cert, certs.Bridge.Key
 }
 
+// HasCustomBridgeTLSCert returns whether a custom certificate was set with SetBridgeTLSCertPath.
+func (vault *Vault) HasCustomBridgeTLSCert() bool {
+	certs := vault.getSafe().Certs
+
+	return certs.CustomCertPath != "" && certs.CustomKeyPath != ""
+}
+
 // SetBridgeTLSCertPath sets the path to PEM-encoded certificates for the bridge.
 func (vault *Vault) SetBridgeTLSCertPath(certPath, keyPath string) error {
 	if _, _, err := readPEMCert(certPath, keyPath); err != nil {
@@ -66,6 +73,23 @@ func (vault *Vault) SetBridgeTLSCertKey(cert, key []byte) error {
 	})
 }
 
+// RegenerateBridgeTLSCert replaces the bridge certificate stored in the vault with a newly generated one.
+// It returns the new PEM-encoded certificate. A custom certificate set with SetBridgeTLSCertPath still takes precedence.
+func (vault *Vault) RegenerateBridgeTLSCert() ([]byte, error) {
+	cert, err := generateTLSCert()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate certificate: %w", err)
+	}
+
+	if err := vault.modSafe(func(data *Data) {
+		data.Certs.Bridge = cert
+	}); err != nil {
+		return nil, err
+	}
+
+	return cert.Cert, nil
+}
+
 func readPEMCert(certPEMPath, keyPEMPath string) ([]byte, []byte, error) {
 	certPEM, err := os.ReadFile(filepath.Clean(certPEMPath))
 	if err != nil {
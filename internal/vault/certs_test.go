@@ -18,6 +18,8 @@
 package vault_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -32,3 +34,21 @@ func TestVault_TLSCerts(t *testing.T) {
 	require.NotEmpty(t, cert)
 	require.NotEmpty(t, key)
 }
+
+func TestVault_TLSCerts_Custom(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// No custom certificate is set by default.
+	require.False(t, s.HasCustomBridgeTLSCert())
+
+	cert, key := s.GetBridgeTLSCert()
+
+	certPath, keyPath := filepath.Join(t.TempDir(), "cert.pem"), filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(certPath, cert, 0o600))
+	require.NoError(t, os.WriteFile(keyPath, key, 0o600))
+
+	// Set a custom certificate.
+	require.NoError(t, s.SetBridgeTLSCertPath(certPath, keyPath))
+	require.True(t, s.HasCustomBridgeTLSCert())
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
 	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -162,6 +163,30 @@ func (vault *Vault) SetShowAllMail(showAllMail bool) error {
 	})
 }
 
+// GetWebSocketEvents returns whether events are also served over a local WebSocket endpoint.
+func (vault *Vault) GetWebSocketEvents() bool {
+	return vault.getSafe().Settings.WebSocketEvents
+}
+
+// SetWebSocketEvents sets whether events are also served over a local WebSocket endpoint.
+func (vault *Vault) SetWebSocketEvents(webSocketEvents bool) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.WebSocketEvents = webSocketEvents
+	})
+}
+
+// GetNotifiedEventTypes returns the types of events pushed to the OS notification center, or nil for the default.
+func (vault *Vault) GetNotifiedEventTypes() []string {
+	return slices.Clone(vault.getSafe().Settings.NotifiedEventTypes)
+}
+
+// SetNotifiedEventTypes sets the types of events pushed to the OS notification center.
+func (vault *Vault) SetNotifiedEventTypes(eventTypes []string) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.NotifiedEventTypes = append([]string{}, eventTypes...)
+	})
+}
+
 // GetAutostart sets whether the bridge should autostart.
 func (vault *Vault) GetAutostart() bool {
 	return vault.getSafe().Settings.Autostart
@@ -155,6 +155,38 @@ func TestVault_Settings_ShowAllMail(t *testing.T) {
 	require.Equal(t, false, s.GetShowAllMail())
 }
 
+func TestVault_Settings_WebSocketEvents(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default WebSocket events setting.
+	require.Equal(t, false, s.GetWebSocketEvents())
+
+	// Modify the WebSocket events setting.
+	require.NoError(t, s.SetWebSocketEvents(true))
+
+	// Check the new WebSocket events setting.
+	require.Equal(t, true, s.GetWebSocketEvents())
+}
+
+func TestVault_Settings_NotifiedEventTypes(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default notified event types setting.
+	require.Nil(t, s.GetNotifiedEventTypes())
+
+	// Modify the notified event types setting.
+	require.NoError(t, s.SetNotifiedEventTypes([]string{"UpdateForceEvent"}))
+
+	// Check the new notified event types setting.
+	require.Equal(t, []string{"UpdateForceEvent"}, s.GetNotifiedEventTypes())
+
+	// Disabling all notifications is not the same as using the default.
+	require.NoError(t, s.SetNotifiedEventTypes(nil))
+	require.Equal(t, []string{}, s.GetNotifiedEventTypes())
+}
+
 func TestVault_Settings_TelemetryDisabled(t *testing.T) {
 	// create a new test vault.
 	s := newVault(t)
@@ -38,18 +38,27 @@ func newDefaultCerts() Certs {
 }
 
 func newTLSCert() Cert {
-	template, err := certs.NewTLSTemplate()
+	cert, err := generateTLSCert()
 	if err != nil {
 		panic(err)
 	}
 
+	return cert
+}
+
+func generateTLSCert() (Cert, error) {
+	template, err := certs.NewTLSTemplate()
+	if err != nil {
+		return Cert{}, err
+	}
+
 	certPEM, keyPEM, err := certs.GenerateCert(template)
 	if err != nil {
-		panic(err)
+		return Cert{}, err
 	}
 
 	return Cert{
 		Cert: certPEM,
 		Key:  keyPEM,
-	}
+	}, nil
 }
@@ -44,6 +44,10 @@ type Settings struct {
 	Autostart         bool
 	AutoUpdate        bool
 	TelemetryDisabled bool
+	WebSocketEvents   bool
+
+	// NotifiedEventTypes is nil until the frontend's default set of notified event types is changed.
+	NotifiedEventTypes []string
 
 	LastVersion string
 	FirstStart  bool
@@ -97,6 +101,7 @@ func newDefaultSettings(gluonDir string) Settings {
 		Autostart:         true,
 		AutoUpdate:        true,
 		TelemetryDisabled: false,
+		WebSocketEvents:   false,
 
 		LastVersion: "0.0.0",
 		FirstStart:  true,
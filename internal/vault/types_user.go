@@ -31,6 +31,9 @@ type UserData struct {
 	BridgePass  []byte // raw token represented as byte slice (needs to be encoded)
 	AddressMode AddressMode
 
+	// SendDedupDisabled turns off the detection of duplicate sends, so that identical messages are always sent.
+	SendDedupDisabled bool
+
 	AuthUID string
 	AuthRef string
 	KeyPass []byte
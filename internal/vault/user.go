@@ -92,6 +92,18 @@ func (user *User) SetAddressMode(mode AddressMode) error {
 	})
 }
 
+// SendDedupEnabled returns whether duplicate sends are detected for the user.
+func (user *User) SendDedupEnabled() bool {
+	return !user.vault.getUser(user.userID).SendDedupDisabled
+}
+
+// SetSendDedupEnabled sets whether duplicate sends are detected for the user.
+func (user *User) SetSendDedupEnabled(enabled bool) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.SendDedupDisabled = !enabled
+	})
+}
+
 // BridgePass returns the user's bridge password as raw token bytes (unencoded).
 func (user *User) BridgePass() []byte {
 	return user.vault.getUser(user.userID).BridgePass
@@ -113,6 +113,26 @@ func TestUser_Delete(t *testing.T) {
 	require.Panics(t, func() { _ = user.AddressMode() })
 }
 
+func TestUser_SendDedup(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// Duplicate sends are detected by default.
+	require.True(t, user.SendDedupEnabled())
+
+	// Disable the detection.
+	require.NoError(t, user.SetSendDedupEnabled(false))
+	require.False(t, user.SendDedupEnabled())
+
+	// Enable it again.
+	require.NoError(t, user.SetSendDedupEnabled(true))
+	require.True(t, user.SendDedupEnabled())
+}
+
 func TestUser_SyncStatus(t *testing.T) {
 	// Create a new test vault.
 	s := newVault(t)